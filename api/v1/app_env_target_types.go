@@ -0,0 +1,28 @@
+package v1
+
+type AppEnvTargetSpec struct {
+	// AppID is the ID or name of the Scalingo application, in the region of the database, whose environment
+	// receives the connection information.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	AppID string `json:"appID"`
+
+	// Variable is the name of the environment variable of the application, e.g. "DATABASE_URL".
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	// +kubebuilder:validation:Required
+	Variable string `json:"variable"`
+
+	// Key is the key of the connection information written in the variable, as in the connection information
+	// secret, e.g. "SCALINGO_POSTGRESQL_VERIFY_FULL_URL".
+	// By default, the main connection URL is written.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+type AppEnvTargetStatus struct {
+	// AppID is the ID or name of the Scalingo application.
+	AppID string `json:"appID"`
+
+	// Variable is the name of the environment variable written by the operator.
+	Variable string `json:"variable"`
+}
@@ -0,0 +1,25 @@
+package v1
+
+type ConfigMapTargetSpec struct {
+	// The name of the config map to create or update with the database metadata.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Prefix for the config map keys.
+	// Added as is without any transformation.
+	// By default, the prefix uses the following format: "SCALINGO_<DB_TYPE>"
+	Prefix string `json:"prefix,omitempty"`
+}
+
+type ConfigMapKeySpec struct {
+	// Name of the config map.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key within the config map.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
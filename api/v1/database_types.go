@@ -0,0 +1,123 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatabaseSpec defines the desired state shared by the database engines.
+// +kubebuilder:validation:XValidation:rule="!(has(self.projectID) && has(self.projectRef))",message="projectID and projectRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.appID) == has(oldSelf.appID) && (!has(self.appID) || self.appID == oldSelf.appID)",message="appID is immutable"
+type DatabaseSpec struct {
+	// AuthSecret contains the references to the authentication details needed to connect to Scalingo.
+	// +kubebuilder:validation:Required
+	AuthSecret AuthSecretSpec `json:"authSecret"`
+
+	// ConnInfoSecretTarget defines where to store the connection information secret.
+	// +kubebuilder:validation:Required
+	ConnInfoSecretTarget SecretTargetSpec `json:"connInfoSecretTarget"`
+
+	// MetadataConfigMapTarget defines where to publish the non-sensitive database information,
+	// such as endpoints hostname and port, version, plan or region.
+	// +optional
+	MetadataConfigMapTarget *ConfigMapTargetSpec `json:"metadataConfigMapTarget,omitempty"`
+
+	// AppEnvTargets lists the Scalingo applications environment variables where the connection information is
+	// written, and kept up to date once the database is provisioned.
+	// +listType=map
+	// +listMapKey=appID
+	// +listMapKey=variable
+	// +optional
+	AppEnvTargets []AppEnvTargetSpec `json:"appEnvTargets,omitempty"`
+
+	// Network defines the networking configuration.
+	// +kubebuilder:validation:Required
+	Networking NetworkingSpec `json:"networking"`
+
+	// Name is the name of the database to create on Scalingo. Fallbacks on meta.name if empty.
+	// +kubebuilder:validation:MinLength=5
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Plan is the plan to use for the database.
+	// +kubebuilder:validation:MinLength=10
+	// +kubebuilder:validation:Required
+	Plan string `json:"plan"`
+
+	// Region is the Scalingo region where the database will be created.
+	// +kubebuilder:default="osc-fr1"
+	// +kubebuilder:validation:MinLength=5
+	Region string `json:"region"`
+
+	// ProjectID is the Scalingo project ID where the database will be created.
+	// If not specified, the default project associated with the authentication token will be used.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	// ProjectRef references a ScalingoProject resource of the namespace, whose Scalingo project is used
+	// instead of ProjectID.
+	// +optional
+	ProjectRef *ProjectReference `json:"projectRef,omitempty"`
+
+	// AppID is the ID or name of an existing Scalingo application. When set, the database is provisioned as
+	// an addon of this application, e.g. on a shared starter plan, instead of a dedicated resources database.
+	// It cannot be set, changed nor removed once the resource is created.
+	// +optional
+	AppID string `json:"appID,omitempty"`
+}
+
+// DatabaseStatus defines the observed state shared by the database engines.
+type DatabaseStatus struct {
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the database resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ScalingoDatabaseID is the unique identifier of the database on Scalingo, or of the addon for the
+	// addons of an existing application.
+	ScalingoDatabaseID string `json:"scalingoDatabaseID,omitempty"`
+
+	// ProjectID is the ID of the Scalingo project of the database, as observed by the operator.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	// MetadataConfigMapName is the name of the metadata ConfigMap written by the operator, deleted when the
+	// metadata ConfigMap target is removed or renamed.
+	// +optional
+	MetadataConfigMapName string `json:"metadataConfigMapName,omitempty"`
+
+	// EndpointServices lists the names of the Services pointing at the database endpoints, as written by the
+	// operator.
+	// +optional
+	EndpointServices []string `json:"endpointServices,omitempty"`
+
+	// FirewallManagedRanges lists the managed ranges referenced by name in the firewall rules, with their
+	// resolved identifier.
+	// +optional
+	FirewallManagedRanges []FirewallManagedRangeStatus `json:"firewallManagedRanges,omitempty"`
+
+	// NetPeerings lists the net peerings of the database, as tracked by the operator.
+	// +optional
+	NetPeerings []NetPeeringStatus `json:"netPeerings,omitempty"`
+
+	// ConnInfoVaultSecret is the Vault secret where the operator wrote the connection information, deleted when
+	// the Vault target is removed or changed, or when the database resource is deleted.
+	// +optional
+	ConnInfoVaultSecret *VaultSecretTargetSpec `json:"connInfoVaultSecret,omitempty"`
+
+	// AppEnvTargets lists the environment variables of the Scalingo applications written by the operator,
+	// unset when the target is removed or the database resource is deleted.
+	// +optional
+	AppEnvTargets []AppEnvTargetStatus `json:"appEnvTargets,omitempty"`
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FirewallRuleSetSpec defines a reusable list of firewall rules.
+type FirewallRuleSetSpec struct {
+	// Rules is a list of firewall rules, merged with the rules of every database referencing this set.
+	// +kubebuilder:validation:MinItems=1
+	Rules []FirewallRuleSpec `json:"rules"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirewallRuleSet is the Schema for the firewallrulesets API.
+// It is referenced by name from the `networking.firewall.rule_sets` field of the databases of the same namespace.
+type FirewallRuleSet struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the firewall rules of the set
+	// +required
+	Spec FirewallRuleSetSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirewallRuleSetList contains a list of FirewallRuleSet
+type FirewallRuleSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FirewallRuleSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FirewallRuleSet{}, &FirewallRuleSetList{})
+}
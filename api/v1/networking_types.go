@@ -1,8 +1,10 @@
 package v1
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 type NetworkingSpec struct {
-	// IPRange is the private network range to use when creating the database.
-	// +kubebuilder:validation:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]{1,2}$`
+	// IPRange is the private network range to use when creating the database, in IPv4 or IPv6 CIDR notation.
+	// +kubebuilder:validation:MaxLength=43
 	// +optional
 	IPRange string `json:"ip_range,omitempty"`
 
@@ -17,22 +19,185 @@ type NetworkingSpec struct {
 	// Firewall defines the firewall rules.
 	// +optional
 	Firewall *FirewallSpec `json:"firewall,omitempty"`
+
+	// Services defines the in-cluster Services pointing at the database endpoints.
+	// +optional
+	Services *ServicesSpec `json:"services,omitempty"`
+
+	// EgressNetworkPolicy generates a NetworkPolicy allowing egress from the selected pods to the database endpoints.
+	// +optional
+	EgressNetworkPolicy *EgressNetworkPolicySpec `json:"egress_network_policy,omitempty"`
 }
 
+// DefaultOutscaleNetPeeringName is the name of the net peering entry when unset.
+const DefaultOutscaleNetPeeringName = "default"
+
+// OKSNetPeeringName is the name of the OKS net peering enabled by oks.net_peering.
+const OKSNetPeeringName = "oks"
+
 func (s NetworkingSpec) IsOutscaleOKSNetPeeringEnabled() bool {
-	return s.Outscale != nil && s.Outscale.OKS != nil && s.Outscale.OKS.NetPeering
+	return len(s.OutscaleOKSNetPeeringNames()) > 0
+}
+
+// OutscaleOKSNetPeeringNames returns the names of the net peerings created through OKS NetPeeringRequests,
+// from both oks.net_peering and oks.net_peerings.
+func (s NetworkingSpec) OutscaleOKSNetPeeringNames() []string {
+	if s.Outscale == nil || s.Outscale.OKS == nil {
+		return nil
+	}
+
+	var names []string
+	if s.Outscale.OKS.NetPeering {
+		names = append(names, OKSNetPeeringName)
+	}
+	for _, netPeering := range s.Outscale.OKS.NetPeerings {
+		names = append(names, netPeering.Name)
+	}
+	return names
+}
+
+func (s NetworkingSpec) IsOutscaleAPINetPeeringEnabled() bool {
+	return len(s.OutscaleAPINetPeerings()) > 0
+}
+
+// OutscaleAPINetPeerings returns the net peerings created through the Outscale API, from both net_peering and
+// net_peerings.
+func (s NetworkingSpec) OutscaleAPINetPeerings() []OutscaleNetPeeringSpec {
+	if s.Outscale == nil {
+		return nil
+	}
+
+	var netPeerings []OutscaleNetPeeringSpec
+	if s.Outscale.NetPeering != nil {
+		netPeering := *s.Outscale.NetPeering
+		if netPeering.Name == "" {
+			netPeering.Name = DefaultOutscaleNetPeeringName
+		}
+		netPeerings = append(netPeerings, netPeering)
+	}
+	return append(netPeerings, s.Outscale.NetPeerings...)
+}
+
+// IsOutscaleNetPeeringEnabled returns whether the net peering is managed, through OKS or the Outscale API.
+func (s NetworkingSpec) IsOutscaleNetPeeringEnabled() bool {
+	return s.IsOutscaleOKSNetPeeringEnabled() || s.IsOutscaleAPINetPeeringEnabled()
+}
+
+func (s NetworkingSpec) IsEndpointServicesEnabled() bool {
+	return s.Services != nil && s.Services.Enabled
+}
+
+type ServicesSpec struct {
+	// Enabled creates a Service named "<resource name>-<endpoint type>" for every database endpoint,
+	// so that applications connect to a stable in-cluster DNS name.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
 }
 
+type EgressNetworkPolicySpec struct {
+	// PodSelector selects the pods allowed to reach the database.
+	// An empty selector selects all the pods of the namespace.
+	// +optional
+	PodSelector metav1.LabelSelector `json:"pod_selector"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!(has(self.net_peering) && has(self.net_peerings))",message="net_peering and net_peerings are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.oks) || !has(self.oks.net_peerings) || self.oks.net_peerings.all(p, (!has(self.net_peering) || (has(self.net_peering.name) ? self.net_peering.name : 'default') != p.name) && (!has(self.net_peerings) || !self.net_peerings.exists(q, q.name == p.name)))",message="the OKS and Outscale API net peering names must be unique"
 type OutscaleSpec struct {
 	// OKS defines the Outscale Kubernetes Service networking configuration.
 	// +optional
 	OKS *OutscaleOKSSpec `json:"oks,omitempty"`
+
+	// NetPeering creates the net peering through the Outscale API, for the clusters which do not run on OKS.
+	// +optional
+	NetPeering *OutscaleNetPeeringSpec `json:"net_peering,omitempty"`
+
+	// NetPeerings creates several net peerings through the Outscale API, e.g. one per cluster reaching the
+	// database. Each net peering is tracked by its name, removing an entry only deletes its net peering.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	NetPeerings []OutscaleNetPeeringSpec `json:"net_peerings,omitempty"`
+}
+
+type OutscaleNetPeeringSpec struct {
+	// Name identifies the net peering in the status, e.g. "staging" or "production".
+	// The "oks" name is reserved for the OKS net peering.
+	// +kubebuilder:default="default"
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self != 'oks'",message="the oks name is reserved"
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// SourceNetID is the ID of the Net (VPC) of the cluster, e.g. "vpc-12345678".
+	// +kubebuilder:validation:Pattern=`^vpc-[0-9a-f]+$`
+	// +kubebuilder:validation:Required
+	SourceNetID string `json:"source_net_id"`
+
+	// AccountID is the Outscale account ID owning the source Net.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	AccountID string `json:"account_id"`
+
+	// Region is the Outscale region of the source Net.
+	// +kubebuilder:default="eu-west-2"
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Endpoint overrides the Outscale API URL, e.g. for a local stand-in.
+	// Defaults to "https://api.<region>.outscale.com/api/v1".
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// CredentialsSecret references the Kubernetes Secret holding the Outscale access key and secret key.
+	// +kubebuilder:validation:Required
+	CredentialsSecret OutscaleCredentialsSecretSpec `json:"credentials_secret"`
+}
+
+type OutscaleCredentialsSecretSpec struct {
+	// Name is the name of the Kubernetes Secret.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// AccessKeyKey is the key within the Secret that holds the access key.
+	// +kubebuilder:default="access_key"
+	// +optional
+	AccessKeyKey string `json:"access_key_key,omitempty"`
+
+	// SecretKeyKey is the key within the Secret that holds the secret key.
+	// +kubebuilder:default="secret_key"
+	// +optional
+	SecretKeyKey string `json:"secret_key_key,omitempty"`
 }
 
 type OutscaleOKSSpec struct {
-	// NetPeering enables Outscale Net Peering management for the database.
+	// NetPeering enables Outscale Net Peering management for the database, through a net peering named "oks".
 	// +optional
 	NetPeering bool `json:"net_peering,omitempty"`
+
+	// NetPeerings creates several net peerings through OKS NetPeeringRequests, e.g. one per OKS cluster reaching
+	// the database. Each net peering has its own NetPeeringRequest and is tracked by its name, removing an entry
+	// only deletes its net peering.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	NetPeerings []OutscaleOKSNetPeeringSpec `json:"net_peerings,omitempty"`
+}
+
+type OutscaleOKSNetPeeringSpec struct {
+	// Name identifies the net peering in the status, e.g. "staging" or "production".
+	// The "oks" name is reserved for the net peering enabled by net_peering.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self != 'oks'",message="the oks name is reserved"
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
 }
 
 type InternetAccessSpec struct {
@@ -43,20 +208,46 @@ type InternetAccessSpec struct {
 	Enabled bool `json:"enabled"`
 }
 
+// +kubebuilder:validation:XValidation:rule="(has(self.rules) && size(self.rules) > 0) || (has(self.rule_sets) && size(self.rule_sets) > 0)",message="at least one rule or rule set is required"
 type FirewallSpec struct {
 	// Rules is a list of firewall rules to be applied.
-	// +kubebuilder:validation:MinItems=1
-	Rules []FirewallRuleSpec `json:"rules"`
+	// +optional
+	Rules []FirewallRuleSpec `json:"rules,omitempty"`
+
+	// RuleSets references FirewallRuleSet resources of the same namespace by name.
+	// Their rules are merged with the inline rules.
+	// +optional
+	RuleSets []string `json:"rule_sets,omitempty"`
+
+	// Exclusive deletes every rule which is not defined by the resource, including the rules added from the
+	// dashboard. By default, only the rules created by the operator are deleted.
+	// +optional
+	Exclusive bool `json:"exclusive,omitempty"`
+
+	// ClusterEgress defines the source of the cluster egress IPs used by the "cluster_egress" type rules.
+	// By default, the ExternalIP addresses of the cluster nodes.
+	// +optional
+	ClusterEgress *ClusterEgressSpec `json:"cluster_egress,omitempty"`
 }
 
+type ClusterEgressSpec struct {
+	// IPsConfigMap references a ConfigMap key listing the cluster egress gateway IPs,
+	// separated by commas, spaces or new lines.
+	// +optional
+	IPsConfigMap *ConfigMapKeySpec `json:"ips_config_map,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!(has(self.range_id) && has(self.range_name))",message="range_id and range_name are mutually exclusive"
 type FirewallRuleSpec struct {
-	// Type of the firewall rule: custom or managed range.
-	// +kubebuilder:validation:Enum=custom_range;managed_range
+	// Type of the firewall rule: custom range, managed range or the cluster egress IPs.
+	// A "cluster_egress" type rule is converted to a "custom_range" rule for every cluster egress IP.
+	// +kubebuilder:validation:Enum=custom_range;managed_range;cluster_egress
 	// +kubebuilder:validation:Required
 	Type string `json:"type"`
 
-	// CIDR is the IP range in CIDR notation for "custom_range" type rules.
-	// +kubebuilder:validation:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]{1,2}$`
+	// CIDR is the IP range in IPv4 or IPv6 CIDR notation for "custom_range" type rules, e.g. "10.0.0.0/8"
+	// or "2001:db8::/32". A non-canonical range such as "10.0.0.1/8" is normalized to "10.0.0.0/8".
+	// +kubebuilder:validation:MaxLength=43
 	// +optional
 	CIDR string `json:"cidr,omitempty"`
 
@@ -69,4 +260,63 @@ type FirewallRuleSpec struct {
 	// +kubebuilder:validation:MinLength=5
 	// +optional
 	RangeID string `json:"range_id,omitempty"`
+
+	// RangeName is the name of the managed range for "managed_range" type rules, e.g. "Scalingo osc-fr1 apps".
+	// It is resolved to the managed range identifier of the database region.
+	// +kubebuilder:validation:MinLength=5
+	// +optional
+	RangeName string `json:"range_name,omitempty"`
+}
+
+// NetPeeringState is the progress of a net peering.
+// +kubebuilder:validation:Enum=Pending;Active;Failed
+type NetPeeringState string
+
+const (
+	NetPeeringStatePending NetPeeringState = "Pending"
+	NetPeeringStateActive  NetPeeringState = "Active"
+	// NetPeeringStateFailed is a net peering which did not become active in time. It is still reconciled and
+	// becomes active as soon as possible.
+	NetPeeringStateFailed NetPeeringState = "Failed"
+)
+
+// NetPeeringStatus is a net peering of the database, either an OKS one or one created through the
+// Outscale API.
+type NetPeeringStatus struct {
+	// Name is the name of the net peering entry, "oks" for the OKS net peering enabled by oks.net_peering.
+	Name string `json:"name"`
+
+	// RequestName is the name of the OKS NetPeeringRequest resource.
+	// +optional
+	RequestName string `json:"requestName,omitempty"`
+
+	// OutscaleNetPeeringID is the ID of the Outscale net peering, e.g. "pcx-12345678".
+	// +optional
+	OutscaleNetPeeringID string `json:"outscaleNetPeeringID,omitempty"`
+
+	// ScalingoNetPeeringID is the ID of the net peering registered on the Scalingo database.
+	// +optional
+	ScalingoNetPeeringID string `json:"scalingoNetPeeringID,omitempty"`
+
+	// OutscaleAPI is the configuration of the net peering created by the operator through the Outscale API,
+	// kept to delete the net peering once its entry is removed or the database resource is deleted.
+	// +optional
+	OutscaleAPI *OutscaleNetPeeringSpec `json:"outscaleAPI,omitempty"`
+
+	// State is the progress of the net peering.
+	// +optional
+	State NetPeeringState `json:"state,omitempty"`
+
+	// PendingSince is the time the net peering was first seen pending, unset once active.
+	// +optional
+	PendingSince *metav1.Time `json:"pendingSince,omitempty"`
+}
+
+// FirewallManagedRangeStatus is a managed range referenced by name in the firewall rules.
+type FirewallManagedRangeStatus struct {
+	// Name is the name of the managed range.
+	Name string `json:"name"`
+
+	// ID is the resolved identifier of the managed range.
+	ID string `json:"id"`
 }
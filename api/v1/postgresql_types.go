@@ -30,37 +30,7 @@ type PostgreSQLSpec struct {
 	// The following markers will use OpenAPI v3 schema to validate the value
 	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
 
-	// AuthSecret contains the references to the authentication details needed to connect to Scalingo.
-	// +kubebuilder:validation:Required
-	AuthSecret AuthSecretSpec `json:"authSecret"`
-
-	// ConnInfoSecretTarget defines where to store the connection information secret.
-	// +kubebuilder:validation:Required
-	ConnInfoSecretTarget SecretTargetSpec `json:"connInfoSecretTarget"`
-
-	// Network defines the networking configuration.
-	// +kubebuilder:validation:Required
-	Networking NetworkingSpec `json:"networking"`
-
-	// Name is the name of the PostgreSQL database to create on Scalingo. Fallbacks on meta.name if empty.
-	// +kubebuilder:validation:MinLength=5
-	// +optional
-	Name string `json:"name,omitempty"`
-
-	// Plan is the plan to use for the PostgreSQL database.
-	// +kubebuilder:validation:MinLength=10
-	// +kubebuilder:validation:Required
-	Plan string `json:"plan"`
-
-	// Region is the Scalingo region where the PostgreSQL database will be created.
-	// +kubebuilder:default="osc-fr1"
-	// +kubebuilder:validation:MinLength=5
-	Region string `json:"region"`
-
-	// ProjectID is the Scalingo project ID where the PostgreSQL database will be created.
-	// If not specified, the default project associated with the authentication token will be used.
-	// +optional
-	ProjectID string `json:"projectID,omitempty"`
+	DatabaseSpec `json:",inline"`
 }
 
 // PostgreSQLStatus defines the observed state of PostgreSQL.
@@ -68,25 +38,7 @@ type PostgreSQLStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	// For Kubernetes API conventions, see:
-	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
-
-	// conditions represent the current state of the PostgreSQL resource.
-	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
-	//
-	// Standard condition types include:
-	// - "Available": the resource is fully functional
-	// - "Progressing": the resource is being created or updated
-	// - "Degraded": the resource failed to reach or maintain its desired state
-	//
-	// The status of each condition is one of True, False, or Unknown.
-	// +listType=map
-	// +listMapKey=type
-	// +optional
-	Conditions []metav1.Condition `json:"conditions,omitempty"`
-
-	// ScalingoDatabaseID is the unique identifier of the PostgreSQL database on Scalingo.
-	ScalingoDatabaseID string `json:"scalingoDatabaseID,omitempty"`
+	DatabaseStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -118,6 +70,16 @@ type PostgreSQLList struct {
 	Items           []PostgreSQL `json:"items"`
 }
 
+// GetDatabaseSpec returns the desired state shared by the database engines.
+func (p *PostgreSQL) GetDatabaseSpec() *DatabaseSpec {
+	return &p.Spec.DatabaseSpec
+}
+
+// GetDatabaseStatus returns the observed state shared by the database engines.
+func (p *PostgreSQL) GetDatabaseStatus() *DatabaseStatus {
+	return &p.Status.DatabaseStatus
+}
+
 func init() {
 	SchemeBuilder.Register(&PostgreSQL{}, &PostgreSQLList{})
 }
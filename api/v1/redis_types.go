@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// RedisSpec defines the desired state of Redis
+type RedisSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	DatabaseSpec `json:",inline"`
+
+	// Persistence is the way Redis persists the data on disk: "rdb" snapshots, or "aof" append only file.
+	// +kubebuilder:validation:Enum=rdb;aof
+	// +kubebuilder:default=rdb
+	// +optional
+	Persistence RedisPersistence `json:"persistence,omitempty"`
+
+	// EvictionPolicy is the policy used to evict keys once the memory limit is reached.
+	// The current policy of the database is kept if empty.
+	// +kubebuilder:validation:Enum=noeviction;allkeys-lru;allkeys-lfu;allkeys-random;volatile-lru;volatile-lfu;volatile-random;volatile-ttl
+	// +optional
+	EvictionPolicy RedisEvictionPolicy `json:"evictionPolicy,omitempty"`
+}
+
+// RedisPersistence is the way Redis persists the data on disk.
+type RedisPersistence string
+
+const (
+	RedisPersistenceRDB RedisPersistence = "rdb"
+	RedisPersistenceAOF RedisPersistence = "aof"
+)
+
+// RedisEvictionPolicy is the policy used by Redis to evict keys once the memory limit is reached.
+type RedisEvictionPolicy string
+
+const (
+	RedisEvictionPolicyNoEviction     RedisEvictionPolicy = "noeviction"
+	RedisEvictionPolicyAllKeysLRU     RedisEvictionPolicy = "allkeys-lru"
+	RedisEvictionPolicyAllKeysLFU     RedisEvictionPolicy = "allkeys-lfu"
+	RedisEvictionPolicyAllKeysRandom  RedisEvictionPolicy = "allkeys-random"
+	RedisEvictionPolicyVolatileLRU    RedisEvictionPolicy = "volatile-lru"
+	RedisEvictionPolicyVolatileLFU    RedisEvictionPolicy = "volatile-lfu"
+	RedisEvictionPolicyVolatileRandom RedisEvictionPolicy = "volatile-random"
+	RedisEvictionPolicyVolatileTTL    RedisEvictionPolicy = "volatile-ttl"
+)
+
+// RedisEvictionPolicies lists the eviction policies supported by Redis.
+var RedisEvictionPolicies = []RedisEvictionPolicy{
+	RedisEvictionPolicyNoEviction,
+	RedisEvictionPolicyAllKeysLRU,
+	RedisEvictionPolicyAllKeysLFU,
+	RedisEvictionPolicyAllKeysRandom,
+	RedisEvictionPolicyVolatileLRU,
+	RedisEvictionPolicyVolatileLFU,
+	RedisEvictionPolicyVolatileRandom,
+	RedisEvictionPolicyVolatileTTL,
+}
+
+// RedisStatus defines the observed state of Redis.
+type RedisStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	DatabaseStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Redis is the Schema for the redis API
+type Redis struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of Redis
+	// +required
+	Spec RedisSpec `json:"spec"`
+
+	// status defines the observed state of Redis
+	// +optional
+	Status RedisStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisList contains a list of Redis
+type RedisList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Redis `json:"items"`
+}
+
+// GetDatabaseSpec returns the desired state shared by the database engines.
+func (r *Redis) GetDatabaseSpec() *DatabaseSpec {
+	return &r.Spec.DatabaseSpec
+}
+
+// GetDatabaseStatus returns the observed state shared by the database engines.
+func (r *Redis) GetDatabaseStatus() *DatabaseStatus {
+	return &r.Status.DatabaseStatus
+}
+
+func init() {
+	SchemeBuilder.Register(&Redis{}, &RedisList{})
+}
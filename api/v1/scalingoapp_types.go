@@ -0,0 +1,180 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalingoAppSpec defines the desired state of ScalingoApp
+// +kubebuilder:validation:XValidation:rule="!(has(self.projectID) && has(self.projectRef))",message="projectID and projectRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.name) == has(oldSelf.name) && (!has(self.name) || self.name == oldSelf.name)",message="name is immutable"
+type ScalingoAppSpec struct {
+	// AuthSecret contains the references to the authentication details needed to connect to Scalingo.
+	// +kubebuilder:validation:Required
+	AuthSecret AuthSecretSpec `json:"authSecret"`
+
+	// Name is the name of the application to create on Scalingo. Fallbacks on meta.name if empty.
+	// It cannot be set, changed nor removed once the resource is created.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Region is the Scalingo region where the application will be created.
+	// +kubebuilder:default="osc-fr1"
+	// +kubebuilder:validation:MinLength=5
+	Region string `json:"region"`
+
+	// ProjectID is the Scalingo project ID of the application.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	// ProjectRef references a ScalingoProject resource of the namespace, whose Scalingo project is used
+	// instead of ProjectID.
+	// +optional
+	ProjectRef *ProjectReference `json:"projectRef,omitempty"`
+
+	// Stack is the name or the ID of the stack of the application, e.g. "scalingo-22". It applies from the
+	// next deployment. Keeps the default stack of the region if empty.
+	// +optional
+	Stack string `json:"stack,omitempty"`
+
+	// Env lists the environment variables of the application. The variables set outside of the operator are
+	// left as is.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Env []AppEnvVarSpec `json:"env,omitempty"`
+
+	// Containers lists the container types of the application to scale. The container types missing from the
+	// list are left as is.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Containers []AppContainerSpec `json:"containers,omitempty"`
+}
+
+// AppEnvVarSpec is an environment variable of a Scalingo application.
+// +kubebuilder:validation:XValidation:rule="has(self.value) != has(self.valueFrom)",message="exactly one of value and valueFrom is required"
+type AppEnvVarSpec struct {
+	// Name is the name of the environment variable.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Value is the value of the environment variable.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueFrom is the source of the value of the environment variable.
+	// +optional
+	ValueFrom *AppEnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// AppEnvVarSource is the source of the value of an environment variable.
+type AppEnvVarSource struct {
+	// SecretKeyRef references a key of a Secret of the namespace.
+	// +kubebuilder:validation:Required
+	SecretKeyRef SecretKeyReference `json:"secretKeyRef"`
+}
+
+// SecretKeyReference references a key of a Secret of the same namespace.
+type SecretKeyReference struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key is the key of the Secret data.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
+// AppContainerSpec is a container type of a Scalingo application, as defined in its Procfile.
+type AppContainerSpec struct {
+	// Name is the name of the container type, e.g. "web".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Amount is the number of containers of the type.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Required
+	Amount int `json:"amount"`
+
+	// Size is the size of the containers, e.g. "M". Keeps the current size if empty.
+	// +optional
+	Size string `json:"size,omitempty"`
+}
+
+// ScalingoAppStatus defines the observed state of ScalingoApp.
+type ScalingoAppStatus struct {
+	// conditions represent the current state of the application resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ScalingoAppID is the unique identifier of the application on Scalingo.
+	ScalingoAppID string `json:"scalingoAppID,omitempty"`
+
+	// URL is the URL of the application.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ProjectID is the ID of the Scalingo project of the application, as observed by the operator.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	// ManagedEnv lists the names of the environment variables set by the operator. They are unset once removed
+	// from the spec.
+	// +optional
+	ManagedEnv []string `json:"managedEnv,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ScalingoApp is the Schema for the scalingoapps API.
+type ScalingoApp struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of ScalingoApp
+	// +required
+	Spec ScalingoAppSpec `json:"spec"`
+
+	// status defines the observed state of ScalingoApp
+	// +optional
+	Status ScalingoAppStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalingoAppList contains a list of ScalingoApp
+type ScalingoAppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScalingoApp `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalingoApp{}, &ScalingoAppList{})
+}
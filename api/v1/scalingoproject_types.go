@@ -0,0 +1,115 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalingoProjectSpec defines the desired state of ScalingoProject
+type ScalingoProjectSpec struct {
+	// AuthSecret contains the references to the authentication details needed to connect to Scalingo.
+	// +kubebuilder:validation:Required
+	AuthSecret AuthSecretSpec `json:"authSecret"`
+
+	// Name is the name of the project to create on Scalingo. Fallbacks on meta.name if empty.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Default makes the project the default project of the account, where the resources without project are
+	// created.
+	// +optional
+	Default bool `json:"default,omitempty"`
+
+	// Region is the Scalingo region where the project will be created.
+	// +kubebuilder:default="osc-fr1"
+	// +kubebuilder:validation:MinLength=5
+	Region string `json:"region"`
+}
+
+// ScalingoProjectStatus defines the observed state of ScalingoProject.
+type ScalingoProjectStatus struct {
+	// conditions represent the current state of the project resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ScalingoProjectID is the unique identifier of the project on Scalingo.
+	ScalingoProjectID string `json:"scalingoProjectID,omitempty"`
+
+	// PrivateNetwork is the private network of the project, once it has one.
+	// +optional
+	PrivateNetwork *ProjectPrivateNetworkStatus `json:"privateNetwork,omitempty"`
+}
+
+// ProjectPrivateNetworkStatus is the private network of a Scalingo project.
+type ProjectPrivateNetworkStatus struct {
+	// ID is the unique identifier of the private network on Scalingo.
+	ID string `json:"id"`
+
+	// Subnet is the CIDR of the private network.
+	Subnet string `json:"subnet"`
+
+	// Gateway is the IP address of the gateway of the private network.
+	Gateway string `json:"gateway"`
+
+	// MaxIPsCount is the number of IP addresses available in the subnet.
+	MaxIPsCount int `json:"maxIPsCount"`
+}
+
+// ProjectReference references a ScalingoProject resource of the same namespace.
+type ProjectReference struct {
+	// Name is the name of the ScalingoProject resource.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ScalingoProject is the Schema for the scalingoprojects API.
+// It is referenced by name from the `projectRef` field of the databases of the same namespace.
+type ScalingoProject struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of ScalingoProject
+	// +required
+	Spec ScalingoProjectSpec `json:"spec"`
+
+	// status defines the observed state of ScalingoProject
+	// +optional
+	Status ScalingoProjectStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalingoProjectList contains a list of ScalingoProject
+type ScalingoProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScalingoProject `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalingoProject{}, &ScalingoProjectList{})
+}
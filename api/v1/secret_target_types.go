@@ -1,5 +1,6 @@
 package v1
 
+// +kubebuilder:validation:XValidation:rule="!has(self.skipKubernetesSecret) || !self.skipKubernetesSecret || has(self.vault)",message="skipKubernetesSecret requires another secret target such as vault"
 type SecretTargetSpec struct {
 	// The name of the secret to create or update with the connection information.
 	// +kubebuilder:validation:MinLength=1
@@ -10,4 +11,48 @@ type SecretTargetSpec struct {
 	// Added as is without any transformation.
 	// By default, the prefix uses the following format: "SCALINGO_<DB_TYPE>_"
 	Prefix string `json:"prefix,omitempty"`
+
+	// PreferredEndpoint selects the database endpoint used by the main connection URL key.
+	// The main key falls back to the default database URL while this endpoint is not available yet,
+	// see the "PreferredEndpointAvailable" status condition.
+	// +kubebuilder:validation:Enum=public-rw;private-peering-rw
+	// +optional
+	PreferredEndpoint string `json:"preferredEndpoint,omitempty"`
+
+	// SkipKubernetesSecret disables writing the connection information in the Kubernetes Secret.
+	// Another secret target must be defined, such as Vault.
+	// +optional
+	SkipKubernetesSecret bool `json:"skipKubernetesSecret,omitempty"`
+
+	// Vault defines a HashiCorp Vault KV v2 secret where the connection information is written.
+	// +optional
+	Vault *VaultSecretTargetSpec `json:"vault,omitempty"`
+}
+
+// IsKubernetesSecretEnabled returns whether the connection information is written in a Kubernetes Secret.
+func (s SecretTargetSpec) IsKubernetesSecretEnabled() bool {
+	return !s.SkipKubernetesSecret
+}
+
+type VaultSecretTargetSpec struct {
+	// Address is the Vault server URL, for example "https://vault.example.com:8200".
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+
+	// Mount is the path where the KV v2 secrets engine is mounted.
+	// +kubebuilder:default="secret"
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// Path is the path of the secret within the mount.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Path string `json:"path"`
+
+	// TokenSecret references the Kubernetes Secret holding the Vault token.
+	// The token requires the "create", "read" and "update" capabilities on the "<mount>/data/<path>" path, and
+	// the "delete" capability on the "<mount>/metadata/<path>" path to delete the secret with all its versions.
+	// +kubebuilder:validation:Required
+	TokenSecret AuthSecretSpec `json:"tokenSecret"`
 }
@@ -25,153 +25,1189 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppContainerSpec) DeepCopyInto(out *AppContainerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppContainerSpec.
+func (in *AppContainerSpec) DeepCopy() *AppContainerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppContainerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppEnvTargetSpec) DeepCopyInto(out *AppEnvTargetSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppEnvTargetSpec.
+func (in *AppEnvTargetSpec) DeepCopy() *AppEnvTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppEnvTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppEnvTargetStatus) DeepCopyInto(out *AppEnvTargetStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppEnvTargetStatus.
+func (in *AppEnvTargetStatus) DeepCopy() *AppEnvTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AppEnvTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppEnvVarSource) DeepCopyInto(out *AppEnvVarSource) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppEnvVarSource.
+func (in *AppEnvVarSource) DeepCopy() *AppEnvVarSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AppEnvVarSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppEnvVarSpec) DeepCopyInto(out *AppEnvVarSpec) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(AppEnvVarSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppEnvVarSpec.
+func (in *AppEnvVarSpec) DeepCopy() *AppEnvVarSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppEnvVarSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthSecretSpec) DeepCopyInto(out *AuthSecretSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretSpec.
-func (in *AuthSecretSpec) DeepCopy() *AuthSecretSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretSpec.
+func (in *AuthSecretSpec) DeepCopy() *AuthSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEgressSpec) DeepCopyInto(out *ClusterEgressSpec) {
+	*out = *in
+	if in.IPsConfigMap != nil {
+		in, out := &in.IPsConfigMap, &out.IPsConfigMap
+		*out = new(ConfigMapKeySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEgressSpec.
+func (in *ClusterEgressSpec) DeepCopy() *ClusterEgressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEgressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySpec) DeepCopyInto(out *ConfigMapKeySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySpec.
+func (in *ConfigMapKeySpec) DeepCopy() *ConfigMapKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapTargetSpec) DeepCopyInto(out *ConfigMapTargetSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapTargetSpec.
+func (in *ConfigMapTargetSpec) DeepCopy() *ConfigMapTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+	*out = *in
+	out.AuthSecret = in.AuthSecret
+	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	if in.MetadataConfigMapTarget != nil {
+		in, out := &in.MetadataConfigMapTarget, &out.MetadataConfigMapTarget
+		*out = new(ConfigMapTargetSpec)
+		**out = **in
+	}
+	if in.AppEnvTargets != nil {
+		in, out := &in.AppEnvTargets, &out.AppEnvTargets
+		*out = make([]AppEnvTargetSpec, len(*in))
+		copy(*out, *in)
+	}
+	in.Networking.DeepCopyInto(&out.Networking)
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(ProjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
+func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EndpointServices != nil {
+		in, out := &in.EndpointServices, &out.EndpointServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FirewallManagedRanges != nil {
+		in, out := &in.FirewallManagedRanges, &out.FirewallManagedRanges
+		*out = make([]FirewallManagedRangeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetPeerings != nil {
+		in, out := &in.NetPeerings, &out.NetPeerings
+		*out = make([]NetPeeringStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnInfoVaultSecret != nil {
+		in, out := &in.ConnInfoVaultSecret, &out.ConnInfoVaultSecret
+		*out = new(VaultSecretTargetSpec)
+		**out = **in
+	}
+	if in.AppEnvTargets != nil {
+		in, out := &in.AppEnvTargets, &out.AppEnvTargets
+		*out = make([]AppEnvTargetStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
+func (in *DatabaseStatus) DeepCopy() *DatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressNetworkPolicySpec) DeepCopyInto(out *EgressNetworkPolicySpec) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressNetworkPolicySpec.
+func (in *EgressNetworkPolicySpec) DeepCopy() *EgressNetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressNetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallManagedRangeStatus) DeepCopyInto(out *FirewallManagedRangeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallManagedRangeStatus.
+func (in *FirewallManagedRangeStatus) DeepCopy() *FirewallManagedRangeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallManagedRangeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleSet) DeepCopyInto(out *FirewallRuleSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleSet.
+func (in *FirewallRuleSet) DeepCopy() *FirewallRuleSet {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallRuleSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleSetList) DeepCopyInto(out *FirewallRuleSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FirewallRuleSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleSetList.
+func (in *FirewallRuleSetList) DeepCopy() *FirewallRuleSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallRuleSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleSetSpec) DeepCopyInto(out *FirewallRuleSetSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FirewallRuleSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleSetSpec.
+func (in *FirewallRuleSetSpec) DeepCopy() *FirewallRuleSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleSpec) DeepCopyInto(out *FirewallRuleSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleSpec.
+func (in *FirewallRuleSpec) DeepCopy() *FirewallRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallSpec) DeepCopyInto(out *FirewallSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FirewallRuleSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.RuleSets != nil {
+		in, out := &in.RuleSets, &out.RuleSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterEgress != nil {
+		in, out := &in.ClusterEgress, &out.ClusterEgress
+		*out = new(ClusterEgressSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallSpec.
+func (in *FirewallSpec) DeepCopy() *FirewallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternetAccessSpec) DeepCopyInto(out *InternetAccessSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetAccessSpec.
+func (in *InternetAccessSpec) DeepCopy() *InternetAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InternetAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDB) DeepCopyInto(out *MongoDB) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDB.
+func (in *MongoDB) DeepCopy() *MongoDB {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDB) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBList) DeepCopyInto(out *MongoDBList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MongoDB, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBList.
+func (in *MongoDBList) DeepCopy() *MongoDBList {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBSpec) DeepCopyInto(out *MongoDBSpec) {
+	*out = *in
+	in.DatabaseSpec.DeepCopyInto(&out.DatabaseSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBSpec.
+func (in *MongoDBSpec) DeepCopy() *MongoDBSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBStatus) DeepCopyInto(out *MongoDBStatus) {
+	*out = *in
+	in.DatabaseStatus.DeepCopyInto(&out.DatabaseStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBStatus.
+func (in *MongoDBStatus) DeepCopy() *MongoDBStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQL) DeepCopyInto(out *MySQL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQL.
+func (in *MySQL) DeepCopy() *MySQL {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLList) DeepCopyInto(out *MySQLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLList.
+func (in *MySQLList) DeepCopy() *MySQLList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLSpec) DeepCopyInto(out *MySQLSpec) {
+	*out = *in
+	in.DatabaseSpec.DeepCopyInto(&out.DatabaseSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSpec.
+func (in *MySQLSpec) DeepCopy() *MySQLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLStatus) DeepCopyInto(out *MySQLStatus) {
+	*out = *in
+	in.DatabaseStatus.DeepCopyInto(&out.DatabaseStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLStatus.
+func (in *MySQLStatus) DeepCopy() *MySQLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetPeeringStatus) DeepCopyInto(out *NetPeeringStatus) {
+	*out = *in
+	if in.OutscaleAPI != nil {
+		in, out := &in.OutscaleAPI, &out.OutscaleAPI
+		*out = new(OutscaleNetPeeringSpec)
+		**out = **in
+	}
+	if in.PendingSince != nil {
+		in, out := &in.PendingSince, &out.PendingSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetPeeringStatus.
+func (in *NetPeeringStatus) DeepCopy() *NetPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetPeeringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkingSpec) DeepCopyInto(out *NetworkingSpec) {
+	*out = *in
+	out.InternetAccess = in.InternetAccess
+	if in.Outscale != nil {
+		in, out := &in.Outscale, &out.Outscale
+		*out = new(OutscaleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(FirewallSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = new(ServicesSpec)
+		**out = **in
+	}
+	if in.EgressNetworkPolicy != nil {
+		in, out := &in.EgressNetworkPolicy, &out.EgressNetworkPolicy
+		*out = new(EgressNetworkPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingSpec.
+func (in *NetworkingSpec) DeepCopy() *NetworkingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearch) DeepCopyInto(out *OpenSearch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearch.
+func (in *OpenSearch) DeepCopy() *OpenSearch {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchList) DeepCopyInto(out *OpenSearchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchList.
+func (in *OpenSearchList) DeepCopy() *OpenSearchList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenSearchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchSpec) DeepCopyInto(out *OpenSearchSpec) {
+	*out = *in
+	in.DatabaseSpec.DeepCopyInto(&out.DatabaseSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSpec.
+func (in *OpenSearchSpec) DeepCopy() *OpenSearchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchStatus) DeepCopyInto(out *OpenSearchStatus) {
+	*out = *in
+	in.DatabaseStatus.DeepCopyInto(&out.DatabaseStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchStatus.
+func (in *OpenSearchStatus) DeepCopy() *OpenSearchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutscaleCredentialsSecretSpec) DeepCopyInto(out *OutscaleCredentialsSecretSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutscaleCredentialsSecretSpec.
+func (in *OutscaleCredentialsSecretSpec) DeepCopy() *OutscaleCredentialsSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutscaleCredentialsSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutscaleNetPeeringSpec) DeepCopyInto(out *OutscaleNetPeeringSpec) {
+	*out = *in
+	out.CredentialsSecret = in.CredentialsSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutscaleNetPeeringSpec.
+func (in *OutscaleNetPeeringSpec) DeepCopy() *OutscaleNetPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutscaleNetPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutscaleOKSNetPeeringSpec) DeepCopyInto(out *OutscaleOKSNetPeeringSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutscaleOKSNetPeeringSpec.
+func (in *OutscaleOKSNetPeeringSpec) DeepCopy() *OutscaleOKSNetPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutscaleOKSNetPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutscaleOKSSpec) DeepCopyInto(out *OutscaleOKSSpec) {
+	*out = *in
+	if in.NetPeerings != nil {
+		in, out := &in.NetPeerings, &out.NetPeerings
+		*out = make([]OutscaleOKSNetPeeringSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutscaleOKSSpec.
+func (in *OutscaleOKSSpec) DeepCopy() *OutscaleOKSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutscaleOKSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutscaleSpec) DeepCopyInto(out *OutscaleSpec) {
+	*out = *in
+	if in.OKS != nil {
+		in, out := &in.OKS, &out.OKS
+		*out = new(OutscaleOKSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetPeering != nil {
+		in, out := &in.NetPeering, &out.NetPeering
+		*out = new(OutscaleNetPeeringSpec)
+		**out = **in
+	}
+	if in.NetPeerings != nil {
+		in, out := &in.NetPeerings, &out.NetPeerings
+		*out = make([]OutscaleNetPeeringSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutscaleSpec.
+func (in *OutscaleSpec) DeepCopy() *OutscaleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutscaleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQL.
+func (in *PostgreSQL) DeepCopy() *PostgreSQL {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLList) DeepCopyInto(out *PostgreSQLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgreSQL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLList.
+func (in *PostgreSQLList) DeepCopy() *PostgreSQLList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgreSQLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
+	*out = *in
+	in.DatabaseSpec.DeepCopyInto(&out.DatabaseSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSpec.
+func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthSecretSpec)
+	out := new(PostgreSQLSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallRuleSpec) DeepCopyInto(out *FirewallRuleSpec) {
+func (in *PostgreSQLStatus) DeepCopyInto(out *PostgreSQLStatus) {
 	*out = *in
+	in.DatabaseStatus.DeepCopyInto(&out.DatabaseStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleSpec.
-func (in *FirewallRuleSpec) DeepCopy() *FirewallRuleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLStatus.
+func (in *PostgreSQLStatus) DeepCopy() *PostgreSQLStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallRuleSpec)
+	out := new(PostgreSQLStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallSpec) DeepCopyInto(out *FirewallSpec) {
+func (in *ProjectPrivateNetworkStatus) DeepCopyInto(out *ProjectPrivateNetworkStatus) {
 	*out = *in
-	if in.Rules != nil {
-		in, out := &in.Rules, &out.Rules
-		*out = make([]FirewallRuleSpec, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPrivateNetworkStatus.
+func (in *ProjectPrivateNetworkStatus) DeepCopy() *ProjectPrivateNetworkStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(ProjectPrivateNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallSpec.
-func (in *FirewallSpec) DeepCopy() *FirewallSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectReference) DeepCopyInto(out *ProjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectReference.
+func (in *ProjectReference) DeepCopy() *ProjectReference {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallSpec)
+	out := new(ProjectReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InternetAccessSpec) DeepCopyInto(out *InternetAccessSpec) {
+func (in *Redis) DeepCopyInto(out *Redis) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetAccessSpec.
-func (in *InternetAccessSpec) DeepCopy() *InternetAccessSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redis.
+func (in *Redis) DeepCopy() *Redis {
 	if in == nil {
 		return nil
 	}
-	out := new(InternetAccessSpec)
+	out := new(Redis)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Redis) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkingSpec) DeepCopyInto(out *NetworkingSpec) {
+func (in *RedisList) DeepCopyInto(out *RedisList) {
 	*out = *in
-	out.InternetAccess = in.InternetAccess
-	if in.Outscale != nil {
-		in, out := &in.Outscale, &out.Outscale
-		*out = new(OutscaleSpec)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Redis, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Firewall != nil {
-		in, out := &in.Firewall, &out.Firewall
-		*out = new(FirewallSpec)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisList.
+func (in *RedisList) DeepCopy() *RedisList {
+	if in == nil {
+		return nil
 	}
+	out := new(RedisList)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingSpec.
-func (in *NetworkingSpec) DeepCopy() *NetworkingSpec {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
+	*out = *in
+	in.DatabaseSpec.DeepCopyInto(&out.DatabaseSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSpec.
+func (in *RedisSpec) DeepCopy() *RedisSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkingSpec)
+	out := new(RedisSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutscaleOKSSpec) DeepCopyInto(out *OutscaleOKSSpec) {
+func (in *RedisStatus) DeepCopyInto(out *RedisStatus) {
 	*out = *in
+	in.DatabaseStatus.DeepCopyInto(&out.DatabaseStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutscaleOKSSpec.
-func (in *OutscaleOKSSpec) DeepCopy() *OutscaleOKSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStatus.
+func (in *RedisStatus) DeepCopy() *RedisStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OutscaleOKSSpec)
+	out := new(RedisStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutscaleSpec) DeepCopyInto(out *OutscaleSpec) {
+func (in *ScalingoApp) DeepCopyInto(out *ScalingoApp) {
 	*out = *in
-	if in.OKS != nil {
-		in, out := &in.OKS, &out.OKS
-		*out = new(OutscaleOKSSpec)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingoApp.
+func (in *ScalingoApp) DeepCopy() *ScalingoApp {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingoApp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalingoApp) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingoAppList) DeepCopyInto(out *ScalingoAppList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalingoApp, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingoAppList.
+func (in *ScalingoAppList) DeepCopy() *ScalingoAppList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingoAppList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalingoAppList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingoAppSpec) DeepCopyInto(out *ScalingoAppSpec) {
+	*out = *in
+	out.AuthSecret = in.AuthSecret
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(ProjectReference)
 		**out = **in
 	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]AppEnvVarSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]AppContainerSpec, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutscaleSpec.
-func (in *OutscaleSpec) DeepCopy() *OutscaleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingoAppSpec.
+func (in *ScalingoAppSpec) DeepCopy() *ScalingoAppSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OutscaleSpec)
+	out := new(ScalingoAppSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
+func (in *ScalingoAppStatus) DeepCopyInto(out *ScalingoAppStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedEnv != nil {
+		in, out := &in.ManagedEnv, &out.ManagedEnv
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingoAppStatus.
+func (in *ScalingoAppStatus) DeepCopy() *ScalingoAppStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingoAppStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingoProject) DeepCopyInto(out *ScalingoProject) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQL.
-func (in *PostgreSQL) DeepCopy() *PostgreSQL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingoProject.
+func (in *ScalingoProject) DeepCopy() *ScalingoProject {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQL)
+	out := new(ScalingoProject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgreSQL) DeepCopyObject() runtime.Object {
+func (in *ScalingoProject) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -179,31 +1215,31 @@ func (in *PostgreSQL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLList) DeepCopyInto(out *PostgreSQLList) {
+func (in *ScalingoProjectList) DeepCopyInto(out *ScalingoProjectList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PostgreSQL, len(*in))
+		*out = make([]ScalingoProject, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLList.
-func (in *PostgreSQLList) DeepCopy() *PostgreSQLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingoProjectList.
+func (in *ScalingoProjectList) DeepCopy() *ScalingoProjectList {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLList)
+	out := new(ScalingoProjectList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
+func (in *ScalingoProjectList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -211,25 +1247,23 @@ func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
+func (in *ScalingoProjectSpec) DeepCopyInto(out *ScalingoProjectSpec) {
 	*out = *in
 	out.AuthSecret = in.AuthSecret
-	out.ConnInfoSecretTarget = in.ConnInfoSecretTarget
-	in.Networking.DeepCopyInto(&out.Networking)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLSpec.
-func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingoProjectSpec.
+func (in *ScalingoProjectSpec) DeepCopy() *ScalingoProjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLSpec)
+	out := new(ScalingoProjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgreSQLStatus) DeepCopyInto(out *PostgreSQLStatus) {
+func (in *ScalingoProjectStatus) DeepCopyInto(out *ScalingoProjectStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -238,14 +1272,34 @@ func (in *PostgreSQLStatus) DeepCopyInto(out *PostgreSQLStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PrivateNetwork != nil {
+		in, out := &in.PrivateNetwork, &out.PrivateNetwork
+		*out = new(ProjectPrivateNetworkStatus)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgreSQLStatus.
-func (in *PostgreSQLStatus) DeepCopy() *PostgreSQLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingoProjectStatus.
+func (in *ScalingoProjectStatus) DeepCopy() *ScalingoProjectStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgreSQLStatus)
+	out := new(ScalingoProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -253,6 +1307,11 @@ func (in *PostgreSQLStatus) DeepCopy() *PostgreSQLStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretTargetSpec) DeepCopyInto(out *SecretTargetSpec) {
 	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSecretTargetSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTargetSpec.
@@ -264,3 +1323,34 @@ func (in *SecretTargetSpec) DeepCopy() *SecretTargetSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicesSpec) DeepCopyInto(out *ServicesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicesSpec.
+func (in *ServicesSpec) DeepCopy() *ServicesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretTargetSpec) DeepCopyInto(out *VaultSecretTargetSpec) {
+	*out = *in
+	out.TokenSecret = in.TokenSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretTargetSpec.
+func (in *VaultSecretTargetSpec) DeepCopy() *VaultSecretTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
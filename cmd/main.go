@@ -180,11 +180,36 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := (&controller.PostgreSQLReconciler{
+	// Each database engine is reconciled by its own controller.
+	databaseEngines := []controller.DatabaseEngine{
+		controller.PostgreSQLEngine{},
+		controller.MySQLEngine{},
+		controller.RedisEngine{},
+		controller.MongoDBEngine{},
+		controller.OpenSearchEngine{},
+	}
+	for _, engine := range databaseEngines {
+		if err := (&controller.DatabaseReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Engine: engine,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", engine.DatabaseType())
+			os.Exit(1)
+		}
+	}
+	if err := (&controller.ScalingoProjectReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ScalingoProject")
+		os.Exit(1)
+	}
+	if err := (&controller.ScalingoAppReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "PostgreSQL")
+		setupLog.Error(err, "unable to create controller", "controller", "ScalingoApp")
 		os.Exit(1)
 	}
 	// +kubebuilder:scaffold:builder
@@ -0,0 +1,209 @@
+package outscale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	errors "github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/outscale"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+const (
+	// Endpoint of the Outscale API, formatted with the region.
+	defaultEndpointFormat = "https://api.%s.outscale.com/api/v1"
+	signatureService      = "api"
+
+	requestTimeout = 30 * time.Second
+)
+
+type client struct {
+	endpoint   string
+	signer     signer
+	httpClient *http.Client
+}
+
+// NewClient returns an Outscale API client. An empty endpoint defaults to the Outscale API of the region,
+// another endpoint such as a local stand-in is used as is.
+func NewClient(ctx context.Context, endpoint, region, accessKey, secretKey string) (outscale.Client, error) {
+	if region == "" {
+		return nil, errors.New(ctx, "empty outscale region")
+	}
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.New(ctx, "empty outscale access key or secret key")
+	}
+
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(defaultEndpointFormat, region)
+	}
+	parsedEndpoint, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "parse outscale endpoint")
+	}
+	if parsedEndpoint.Scheme != "http" && parsedEndpoint.Scheme != "https" {
+		return nil, errors.Newf(ctx, "invalid outscale endpoint scheme %q", parsedEndpoint.Scheme)
+	}
+
+	return &client{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		signer: signer{
+			accessKey: accessKey,
+			secretKey: secretKey,
+			region:    region,
+			service:   signatureService,
+		},
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+type netPeering struct {
+	NetPeeringID string `json:"NetPeeringId"`
+	State        struct {
+		Name    string `json:"Name"`
+		Message string `json:"Message"`
+	} `json:"State"`
+	SourceNet   peeringNet `json:"SourceNet"`
+	AccepterNet peeringNet `json:"AccepterNet"`
+}
+
+type peeringNet struct {
+	NetID     string `json:"NetId"`
+	AccountID string `json:"AccountId"`
+}
+
+type createNetPeeringRequest struct {
+	SourceNetID     string `json:"SourceNetId"`
+	AccepterNetID   string `json:"AccepterNetId"`
+	AccepterOwnerID string `json:"AccepterOwnerId"`
+}
+
+type createNetPeeringResponse struct {
+	NetPeering netPeering `json:"NetPeering"`
+}
+
+type readNetPeeringsRequest struct {
+	Filters readNetPeeringsFilters `json:"Filters"`
+}
+
+type readNetPeeringsFilters struct {
+	SourceNetNetIDs   []string `json:"SourceNetNetIds"`
+	AccepterNetNetIDs []string `json:"AccepterNetNetIds"`
+}
+
+type readNetPeeringsResponse struct {
+	NetPeerings []netPeering `json:"NetPeerings"`
+}
+
+type deleteNetPeeringRequest struct {
+	NetPeeringID string `json:"NetPeeringId"`
+}
+
+type errorsResponse struct {
+	Errors []struct {
+		Code    string `json:"Code"`
+		Type    string `json:"Type"`
+		Details string `json:"Details"`
+	} `json:"Errors"`
+}
+
+func (c *client) CreateNetPeering(ctx context.Context, sourceNetID, accepterNetID, accepterAccountID string) (domain.OutscaleNetPeering, error) {
+	var res createNetPeeringResponse
+	err := c.do(ctx, "CreateNetPeering", createNetPeeringRequest{
+		SourceNetID:     sourceNetID,
+		AccepterNetID:   accepterNetID,
+		AccepterOwnerID: accepterAccountID,
+	}, &res)
+	if err != nil {
+		return domain.OutscaleNetPeering{}, errors.Wrap(ctx, err, "create net peering")
+	}
+	return toOutscaleNetPeering(res.NetPeering), nil
+}
+
+func (c *client) ListNetPeerings(ctx context.Context, sourceNetID, accepterNetID string) ([]domain.OutscaleNetPeering, error) {
+	var res readNetPeeringsResponse
+	err := c.do(ctx, "ReadNetPeerings", readNetPeeringsRequest{
+		Filters: readNetPeeringsFilters{
+			SourceNetNetIDs:   []string{sourceNetID},
+			AccepterNetNetIDs: []string{accepterNetID},
+		},
+	}, &res)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "read net peerings")
+	}
+
+	netPeerings := make([]domain.OutscaleNetPeering, 0, len(res.NetPeerings))
+	for _, netPeering := range res.NetPeerings {
+		netPeerings = append(netPeerings, toOutscaleNetPeering(netPeering))
+	}
+	return netPeerings, nil
+}
+
+func (c *client) DeleteNetPeering(ctx context.Context, netPeeringID string) error {
+	err := c.do(ctx, "DeleteNetPeering", deleteNetPeeringRequest{NetPeeringID: netPeeringID}, nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, "delete net peering")
+	}
+	return nil
+}
+
+func toOutscaleNetPeering(netPeering netPeering) domain.OutscaleNetPeering {
+	return domain.OutscaleNetPeering{
+		ID:                netPeering.NetPeeringID,
+		State:             domain.OutscaleNetPeeringState(netPeering.State.Name),
+		SourceNetID:       netPeering.SourceNet.NetID,
+		SourceAccountID:   netPeering.SourceNet.AccountID,
+		AccepterNetID:     netPeering.AccepterNet.NetID,
+		AccepterAccountID: netPeering.AccepterNet.AccountID,
+	}
+}
+
+// do calls an Outscale API action, e.g. "ReadNetPeerings", and decodes its response in `res` if not nil.
+func (c *client) do(ctx context.Context, action string, params any, res any) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return errors.Wrap(ctx, err, "marshal request body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/"+action, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(ctx, err, "new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.signer.sign(req, body, time.Now())
+
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "call %s", action)
+	}
+	defer httpRes.Body.Close()
+
+	resBody, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		return errors.Wrap(ctx, err, "read response body")
+	}
+
+	if httpRes.StatusCode != http.StatusOK {
+		var errorsRes errorsResponse
+		if json.Unmarshal(resBody, &errorsRes) == nil && len(errorsRes.Errors) > 0 {
+			apiErr := errorsRes.Errors[0]
+			return errors.Newf(ctx, "%s: status %d: %s %s: %s", action, httpRes.StatusCode, apiErr.Code, apiErr.Type, apiErr.Details)
+		}
+		return errors.Newf(ctx, "%s: unexpected status %d", action, httpRes.StatusCode)
+	}
+
+	if res == nil {
+		return nil
+	}
+	err = json.Unmarshal(resBody, res)
+	if err != nil {
+		return errors.Wrap(ctx, err, "unmarshal response body")
+	}
+	return nil
+}
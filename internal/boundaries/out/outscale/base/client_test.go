@@ -0,0 +1,150 @@
+package outscale
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Run("it fails because of empty region", func(t *testing.T) {
+		outscaleClient, err := NewClient(t.Context(), "", "", "AK", "SK")
+		require.EqualError(t, err, "empty outscale region")
+		require.Nil(t, outscaleClient)
+	})
+
+	t.Run("it fails because of empty credentials", func(t *testing.T) {
+		outscaleClient, err := NewClient(t.Context(), "", "eu-west-2", "AK", "")
+		require.EqualError(t, err, "empty outscale access key or secret key")
+		require.Nil(t, outscaleClient)
+	})
+
+	t.Run("it fails because of invalid endpoint scheme", func(t *testing.T) {
+		outscaleClient, err := NewClient(t.Context(), "api.example.test", "eu-west-2", "AK", "SK")
+		require.ErrorContains(t, err, "invalid outscale endpoint scheme")
+		require.Nil(t, outscaleClient)
+	})
+
+	t.Run("it defaults to the Outscale API of the region", func(t *testing.T) {
+		outscaleClient, err := NewClient(t.Context(), "", "eu-west-2", "AK", "SK")
+		require.NoError(t, err)
+		require.Equal(t, "https://api.eu-west-2.outscale.com/api/v1", outscaleClient.(*client).endpoint)
+	})
+}
+
+func TestClient_CreateNetPeering(t *testing.T) {
+	t.Run("it creates the net peering", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/v1/CreateNetPeering", r.URL.Path)
+			assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AK/"))
+			assert.Contains(t, r.Header.Get("Authorization"), "/eu-west-2/api/aws4_request")
+
+			var req createNetPeeringRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, createNetPeeringRequest{SourceNetID: "vpc-source", AccepterNetID: "vpc-db", AccepterOwnerID: "123456789012"}, req)
+
+			_, writeErr := w.Write([]byte(`{"NetPeering":{"NetPeeringId":"pcx-1234","State":{"Name":"pending-acceptance"},
+				"SourceNet":{"NetId":"vpc-source","AccountId":"210987654321"},"AccepterNet":{"NetId":"vpc-db","AccountId":"123456789012"}}}`))
+			assert.NoError(t, writeErr)
+		}))
+		defer server.Close()
+
+		outscaleClient, err := NewClient(t.Context(), server.URL+"/api/v1/", "eu-west-2", "AK", "SK")
+		require.NoError(t, err)
+
+		netPeering, err := outscaleClient.CreateNetPeering(t.Context(), "vpc-source", "vpc-db", "123456789012")
+		require.NoError(t, err)
+		require.Equal(t, domain.OutscaleNetPeering{
+			ID:                "pcx-1234",
+			State:             domain.OutscaleNetPeeringStatePendingAcceptance,
+			SourceNetID:       "vpc-source",
+			SourceAccountID:   "210987654321",
+			AccepterNetID:     "vpc-db",
+			AccepterAccountID: "123456789012",
+		}, netPeering)
+	})
+
+	t.Run("it returns the API error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, writeErr := w.Write([]byte(`{"Errors":[{"Code":"5065","Type":"InvalidResource","Details":"The Net does not exist."}]}`))
+			assert.NoError(t, writeErr)
+		}))
+		defer server.Close()
+
+		outscaleClient, err := NewClient(t.Context(), server.URL, "eu-west-2", "AK", "SK")
+		require.NoError(t, err)
+
+		_, err = outscaleClient.CreateNetPeering(t.Context(), "vpc-source", "vpc-db", "123456789012")
+		require.ErrorContains(t, err, "CreateNetPeering: status 400: 5065 InvalidResource: The Net does not exist.")
+	})
+}
+
+func TestClient_ListNetPeerings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ReadNetPeerings", r.URL.Path)
+
+		var req readNetPeeringsRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []string{"vpc-source"}, req.Filters.SourceNetNetIDs)
+		assert.Equal(t, []string{"vpc-db"}, req.Filters.AccepterNetNetIDs)
+
+		_, writeErr := w.Write([]byte(`{"NetPeerings":[{"NetPeeringId":"pcx-1234","State":{"Name":"active"}}]}`))
+		assert.NoError(t, writeErr)
+	}))
+	defer server.Close()
+
+	outscaleClient, err := NewClient(t.Context(), server.URL, "eu-west-2", "AK", "SK")
+	require.NoError(t, err)
+
+	netPeerings, err := outscaleClient.ListNetPeerings(t.Context(), "vpc-source", "vpc-db")
+	require.NoError(t, err)
+	require.Equal(t, []domain.OutscaleNetPeering{{ID: "pcx-1234", State: domain.OutscaleNetPeeringStateActive}}, netPeerings)
+}
+
+func TestSigner_sign(t *testing.T) {
+	// Known answers of the Signature Version 4 test suite.
+	s := signer{
+		accessKey: "AKIDEXAMPLE",
+		secretKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		region:    "us-east-1",
+		service:   "service",
+	}
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	t.Run("it signs the get-vanilla request", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+		require.NoError(t, err)
+
+		s.sign(req, nil, now)
+
+		require.Equal(t, "20150830T123600Z", req.Header.Get(signatureDateHeader))
+		require.Equal(t,
+			"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, "+
+				"Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+			req.Header.Get("Authorization"))
+	})
+
+	t.Run("it signs the post-x-www-form-urlencoded request with its content type and body", func(t *testing.T) {
+		body := []byte("Param1=value1")
+		req, err := http.NewRequest(http.MethodPost, "https://example.amazonaws.com/", strings.NewReader(string(body)))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		s.sign(req, body, now)
+
+		require.Equal(t,
+			"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=content-type;host;x-amz-date, "+
+				"Signature=ff11897932ad3f4e8b18135d722051e5ac45fc38421b1da7b9d196a0fe09473a",
+			req.Header.Get("Authorization"))
+	})
+}
@@ -0,0 +1,89 @@
+package outscale
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	signatureAlgorithm  = "AWS4-HMAC-SHA256"
+	signatureDateHeader = "X-Amz-Date"
+	signatureTerminator = "aws4_request"
+)
+
+// signer signs the Outscale API requests with the access key / secret key pair, following the
+// Signature Version 4 process.
+type signer struct {
+	accessKey string
+	secretKey string
+	region    string
+	service   string
+}
+
+func (s signer) sign(req *http.Request, body []byte, now time.Time) {
+	requestDate := now.UTC().Format("20060102T150405Z")
+	date := requestDate[:8]
+	req.Header.Set(signatureDateHeader, requestDate)
+
+	headers := map[string]string{
+		"host":       req.URL.Host,
+		"x-amz-date": requestDate,
+	}
+	signedHeaders := []string{"host", "x-amz-date"}
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		headers["content-type"] = contentType
+		signedHeaders = []string{"content-type", "host", "x-amz-date"}
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+
+	canonicalURI := req.URL.EscapedPath()
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		sha256Hex(body),
+	}, "\n")
+
+	scope := strings.Join([]string{date, s.region, s.service, signatureTerminator}, "/")
+	stringToSign := strings.Join([]string{
+		signatureAlgorithm,
+		requestDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s.service)
+	key = hmacSHA256(key, signatureTerminator)
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signatureAlgorithm, s.accessKey, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,18 @@
+package outscale
+
+import (
+	"context"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// Wrapper for the Outscale API net peering calls.
+type Client interface {
+	// CreateNetPeering requests a peering from the source Net to the accepter Net of another account.
+	// The peering is pending until the accepter accepts it: only the accepter account can accept a peering,
+	// there is no accept call on the source account side.
+	CreateNetPeering(ctx context.Context, sourceNetID, accepterNetID, accepterAccountID string) (domain.OutscaleNetPeering, error)
+	// ListNetPeerings returns the peerings between the source Net and the accepter Net.
+	ListNetPeerings(ctx context.Context, sourceNetID, accepterNetID string) ([]domain.OutscaleNetPeering, error)
+	DeleteNetPeering(ctx context.Context, netPeeringID string) error
+}
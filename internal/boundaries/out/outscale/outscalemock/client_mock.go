@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/Scalingo/scalingo-operator/internal/boundaries/out/outscale (interfaces: Client)
+
+// Package outscalemock is a generated GoMock package.
+package outscalemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Scalingo/scalingo-operator/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+	isgomock struct{}
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// CreateNetPeering mocks base method.
+func (m *MockClient) CreateNetPeering(ctx context.Context, sourceNetID, accepterNetID, accepterAccountID string) (domain.OutscaleNetPeering, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetPeering", ctx, sourceNetID, accepterNetID, accepterAccountID)
+	ret0, _ := ret[0].(domain.OutscaleNetPeering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetPeering indicates an expected call of CreateNetPeering.
+func (mr *MockClientMockRecorder) CreateNetPeering(ctx, sourceNetID, accepterNetID, accepterAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetPeering", reflect.TypeOf((*MockClient)(nil).CreateNetPeering), ctx, sourceNetID, accepterNetID, accepterAccountID)
+}
+
+// DeleteNetPeering mocks base method.
+func (m *MockClient) DeleteNetPeering(ctx context.Context, netPeeringID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetPeering", ctx, netPeeringID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetPeering indicates an expected call of DeleteNetPeering.
+func (mr *MockClientMockRecorder) DeleteNetPeering(ctx, netPeeringID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetPeering", reflect.TypeOf((*MockClient)(nil).DeleteNetPeering), ctx, netPeeringID)
+}
+
+// ListNetPeerings mocks base method.
+func (m *MockClient) ListNetPeerings(ctx context.Context, sourceNetID, accepterNetID string) ([]domain.OutscaleNetPeering, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetPeerings", ctx, sourceNetID, accepterNetID)
+	ret0, _ := ret[0].([]domain.OutscaleNetPeering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetPeerings indicates an expected call of ListNetPeerings.
+func (mr *MockClientMockRecorder) ListNetPeerings(ctx, sourceNetID, accepterNetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetPeerings", reflect.TypeOf((*MockClient)(nil).ListNetPeerings), ctx, sourceNetID, accepterNetID)
+}
@@ -0,0 +1,78 @@
+package adapters
+
+import (
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func ToApp(app scalingoapi.App) domain.App {
+	return domain.App{
+		ID:        app.ID,
+		Name:      app.Name,
+		ProjectID: app.Project.ID,
+		URL:       app.URL,
+		Stack:     app.StackID,
+	}
+}
+
+func ToAppVariables(variables scalingoapi.Variables) []domain.AppVariable {
+	if len(variables) == 0 {
+		return nil
+	}
+
+	res := make([]domain.AppVariable, 0, len(variables))
+	for _, variable := range variables {
+		res = append(res, domain.AppVariable{
+			ID:    variable.ID,
+			Name:  variable.Name,
+			Value: variable.Value,
+		})
+	}
+	return res
+}
+
+func ToScalingoVariables(variables []domain.AppVariable) scalingoapi.Variables {
+	res := make(scalingoapi.Variables, 0, len(variables))
+	for _, variable := range variables {
+		res = append(res, &scalingoapi.Variable{
+			Name:  variable.Name,
+			Value: variable.Value,
+		})
+	}
+	return res
+}
+
+func ToAppContainers(containerTypes []scalingoapi.ContainerType) []domain.AppContainer {
+	if len(containerTypes) == 0 {
+		return nil
+	}
+
+	res := make([]domain.AppContainer, 0, len(containerTypes))
+	for _, containerType := range containerTypes {
+		res = append(res, domain.AppContainer{
+			Name:   containerType.Name,
+			Amount: containerType.Amount,
+			Size:   containerType.Size,
+		})
+	}
+	return res
+}
+
+func ToScalingoContainerTypes(containers []domain.AppContainer) []scalingoapi.ContainerType {
+	res := make([]scalingoapi.ContainerType, 0, len(containers))
+	for _, container := range containers {
+		res = append(res, scalingoapi.ContainerType{
+			Name:   container.Name,
+			Amount: container.Amount,
+			Size:   container.Size,
+		})
+	}
+	return res
+}
+
+func ToStack(stack scalingoapi.Stack) domain.Stack {
+	return domain.Stack{
+		ID:   stack.ID,
+		Name: stack.Name,
+	}
+}
@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestToApp(t *testing.T) {
+	t.Run("it converts the app with its project and stack", func(t *testing.T) {
+		app := scalingoapi.App{
+			ID:      "app-123",
+			Name:    "my-app",
+			URL:     "https://my-app.osc-fr1.scalingo.io",
+			StackID: "st-123",
+		}
+		app.Project.ID = "prj-123"
+
+		require.Equal(t, domain.App{
+			ID:        "app-123",
+			Name:      "my-app",
+			ProjectID: "prj-123",
+			URL:       "https://my-app.osc-fr1.scalingo.io",
+			Stack:     "st-123",
+		}, ToApp(app))
+	})
+}
+
+func TestToAppVariables(t *testing.T) {
+	t.Run("it returns nil without variable", func(t *testing.T) {
+		require.Nil(t, ToAppVariables(nil))
+	})
+
+	t.Run("it converts the variables", func(t *testing.T) {
+		variables := ToAppVariables(scalingoapi.Variables{{ID: "var-1", Name: "LOG_LEVEL", Value: "debug"}})
+
+		require.Equal(t, []domain.AppVariable{{ID: "var-1", Name: "LOG_LEVEL", Value: "debug"}}, variables)
+	})
+}
+
+func TestToScalingoVariables(t *testing.T) {
+	t.Run("it converts the variables without their ID", func(t *testing.T) {
+		variables := ToScalingoVariables([]domain.AppVariable{{ID: "var-1", Name: "LOG_LEVEL", Value: "debug"}})
+
+		require.Equal(t, scalingoapi.Variables{{Name: "LOG_LEVEL", Value: "debug"}}, variables)
+	})
+}
+
+func TestToAppContainers(t *testing.T) {
+	t.Run("it converts the container types", func(t *testing.T) {
+		containers := ToAppContainers([]scalingoapi.ContainerType{{AppID: "app-123", Name: "web", Amount: 2, Size: "M", Command: "npm start"}})
+
+		require.Equal(t, []domain.AppContainer{{Name: "web", Amount: 2, Size: "M"}}, containers)
+	})
+}
+
+func TestToScalingoContainerTypes(t *testing.T) {
+	t.Run("it converts the containers", func(t *testing.T) {
+		containerTypes := ToScalingoContainerTypes([]domain.AppContainer{{Name: "worker", Amount: 1}})
+
+		require.Equal(t, []scalingoapi.ContainerType{{Name: "worker", Amount: 1}}, containerTypes)
+	})
+}
@@ -9,17 +9,57 @@ import (
 	"github.com/Scalingo/scalingo-operator/internal/domain"
 )
 
-const postgresqlAddonProviderID = "postgresql-ng"
+const (
+	postgresqlAddonProviderID = "postgresql-ng"
+	mysqlAddonProviderID      = "mysql-ng"
+	redisAddonProviderID      = "redis-ng"
+	mongodbAddonProviderID    = "mongodb-ng"
+	opensearchAddonProviderID = "opensearch-ng"
+
+	// Addon providers of the databases shared by the applications.
+	postgresqlAppAddonProviderID = "postgresql"
+	mysqlAppAddonProviderID      = "mysql"
+	redisAppAddonProviderID      = "redis"
+	mongodbAppAddonProviderID    = "mongodb"
+	opensearchAppAddonProviderID = "opensearch"
+)
 
 func ToScalingoProviderID(dbType domain.DatabaseType) (string, error) {
 	switch dbType {
 	case domain.DatabaseTypePostgreSQL:
 		return postgresqlAddonProviderID, nil
+	case domain.DatabaseTypeMySQL:
+		return mysqlAddonProviderID, nil
+	case domain.DatabaseTypeRedis:
+		return redisAddonProviderID, nil
+	case domain.DatabaseTypeMongoDB:
+		return mongodbAddonProviderID, nil
+	case domain.DatabaseTypeOpenSearch:
+		return opensearchAddonProviderID, nil
 	default:
 		return "", fmt.Errorf("no matching provider for %q", dbType)
 	}
 }
 
+// ToScalingoAppAddonProviderID returns the addon provider of the database type, for the addons of an existing
+// application.
+func ToScalingoAppAddonProviderID(dbType domain.DatabaseType) (string, error) {
+	switch dbType {
+	case domain.DatabaseTypePostgreSQL:
+		return postgresqlAppAddonProviderID, nil
+	case domain.DatabaseTypeMySQL:
+		return mysqlAppAddonProviderID, nil
+	case domain.DatabaseTypeRedis:
+		return redisAppAddonProviderID, nil
+	case domain.DatabaseTypeMongoDB:
+		return mongodbAppAddonProviderID, nil
+	case domain.DatabaseTypeOpenSearch:
+		return opensearchAppAddonProviderID, nil
+	default:
+		return "", fmt.Errorf("no matching addon provider for %q", dbType)
+	}
+}
+
 func toDatabaseStatus(status scalingoapi.DatabaseStatus) (domain.DatabaseStatus, error) {
 	switch status {
 	case scalingoapi.DatabaseStatusCreating, scalingoapi.DatabaseStatusUpdating,
@@ -34,6 +74,35 @@ func toDatabaseStatus(status scalingoapi.DatabaseStatus) (domain.DatabaseStatus,
 	}
 }
 
+// toDatabaseFeatures returns the features of the database by name, the failed ones being disabled.
+func toDatabaseFeatures(features []scalingoapi.DatabaseFeature) map[string]bool {
+	if len(features) == 0 {
+		return nil
+	}
+
+	res := make(map[string]bool, len(features))
+	for _, feature := range features {
+		res[feature.Name] = feature.Status != scalingoapi.DatabaseFeatureStatusFailed
+	}
+	return res
+}
+
+func toDatabaseInstances(instances []scalingoapi.Instance) []domain.DatabaseInstance {
+	if len(instances) == 0 {
+		return nil
+	}
+
+	res := make([]domain.DatabaseInstance, 0, len(instances))
+	for _, instance := range instances {
+		res = append(res, domain.DatabaseInstance{
+			Hostname: instance.Hostname,
+			Port:     instance.Port,
+			Type:     domain.DatabaseInstanceType(instance.Type),
+		})
+	}
+	return res
+}
+
 func ToDatabase(ctx context.Context, db scalingoapi.DatabaseNG) (domain.Database, error) {
 	var dbType domain.DatabaseType
 	var dbStatus domain.DatabaseStatus
@@ -61,6 +130,71 @@ func ToDatabase(ctx context.Context, db scalingoapi.DatabaseNG) (domain.Database
 		Technology: db.Technology,
 		Status:     dbStatus,
 		Plan:       db.Plan,
+		Version:    db.Database.ReadableVersion,
 		ProjectID:  db.ProjectID,
+		Features:   toDatabaseFeatures(db.Database.Features),
+
+		MongoReplSetName: db.Database.MongoReplSetName,
+		Instances:        toDatabaseInstances(db.Database.Instances),
+	}, nil
+}
+
+func toAddonStatus(status scalingoapi.AddonStatus) (domain.DatabaseStatus, error) {
+	switch status {
+	case scalingoapi.AddonStatusProvisioning:
+		return domain.DatabaseStatusProvisioning, nil
+	case scalingoapi.AddonStatusRunning:
+		return domain.DatabaseStatusRunning, nil
+	case scalingoapi.AddonStatusSuspended:
+		return domain.DatabaseStatusStopped, nil
+	default:
+		return domain.DatabaseStatus(""), fmt.Errorf("unknown addon status %v", status)
+	}
+}
+
+// AddonToDatabase converts the addon of an existing application to internal type. The database details,
+// only available once the addon is provisioned, take precedence over the addon status.
+func AddonToDatabase(ctx context.Context, appID string, addon scalingoapi.Addon, db scalingoapi.Database) (domain.Database, error) {
+	dbStatus, err := toAddonStatus(addon.Status)
+	if err != nil {
+		return domain.Database{}, errors.Wrap(ctx, err, "to addon status")
+	}
+
+	var dbType domain.DatabaseType
+	if db.ID != "" {
+		dbType = domain.DatabaseType(db.TypeName)
+		err = dbType.Validate()
+		if err != nil {
+			return domain.Database{}, errors.Wrap(ctx, err, "to database type")
+		}
+
+		dbStatus, err = toDatabaseStatus(db.Status)
+		if err != nil {
+			return domain.Database{}, errors.Wrap(ctx, err, "to database status")
+		}
+	}
+
+	var technology, plan string
+	if addon.AddonProvider != nil {
+		technology = addon.AddonProvider.ID
+	}
+	if addon.Plan != nil {
+		plan = addon.Plan.Name
+	}
+
+	return domain.Database{
+		ID:         addon.ID,
+		AppID:      appID,
+		AddonID:    addon.ID,
+		Name:       addon.ResourceID,
+		Type:       dbType,
+		Technology: technology,
+		Status:     dbStatus,
+		Plan:       plan,
+		Version:    db.ReadableVersion,
+		Features:   toDatabaseFeatures(db.Features),
+
+		MongoReplSetName: db.MongoReplSetName,
+		Instances:        toDatabaseInstances(db.Instances),
 	}, nil
 }
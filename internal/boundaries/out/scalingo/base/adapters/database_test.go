@@ -27,6 +27,22 @@ func TestToScalingoProviderID(t *testing.T) {
 			dbType:             domain.DatabaseTypePostgreSQL,
 			scalingoProviderId: postgresqlAddonProviderID,
 		},
+		"it successfully extract MySQL Scalingo addon provider ID": {
+			dbType:             domain.DatabaseTypeMySQL,
+			scalingoProviderId: mysqlAddonProviderID,
+		},
+		"it successfully extract Redis Scalingo addon provider ID": {
+			dbType:             domain.DatabaseTypeRedis,
+			scalingoProviderId: redisAddonProviderID,
+		},
+		"it successfully extract MongoDB Scalingo addon provider ID": {
+			dbType:             domain.DatabaseTypeMongoDB,
+			scalingoProviderId: mongodbAddonProviderID,
+		},
+		"it successfully extract OpenSearch Scalingo addon provider ID": {
+			dbType:             domain.DatabaseTypeOpenSearch,
+			scalingoProviderId: opensearchAddonProviderID,
+		},
 	}
 
 	for name, test := range tests {
@@ -120,6 +136,7 @@ func TestToDatabase(t *testing.T) {
 			dbPlan       = "db_plan_name"
 			appID        = "app_id"
 			dbTechnology = "db_technology"
+			dbVersion    = "17.4.0-1"
 		)
 
 		db := scalingoapi.DatabaseNG{
@@ -128,9 +145,10 @@ func TestToDatabase(t *testing.T) {
 			Technology: dbTechnology,
 			Plan:       dbPlan,
 			Database: scalingoapi.Database{
-				ID:       dbID,
-				TypeName: "postgresql",
-				Status:   scalingoapi.DatabaseStatusRunning,
+				ID:              dbID,
+				TypeName:        "postgresql",
+				Status:          scalingoapi.DatabaseStatusRunning,
+				ReadableVersion: dbVersion,
 			},
 			App: scalingoapi.App{
 				ID: appID,
@@ -145,6 +163,7 @@ func TestToDatabase(t *testing.T) {
 			Type:       domain.DatabaseTypePostgreSQL,
 			Status:     domain.DatabaseStatusRunning,
 			Plan:       dbPlan,
+			Version:    dbVersion,
 		}
 
 		res, err := ToDatabase(ctx, db)
@@ -153,3 +172,119 @@ func TestToDatabase(t *testing.T) {
 		require.Equal(t, expectedDB, res)
 	})
 }
+
+func TestToDatabaseFeatures(t *testing.T) {
+	t.Run("it returns nothing without features", func(t *testing.T) {
+		require.Nil(t, toDatabaseFeatures(nil))
+	})
+
+	t.Run("it disables the failed features", func(t *testing.T) {
+		features := toDatabaseFeatures([]scalingoapi.DatabaseFeature{
+			{Name: "redis-aof", Status: scalingoapi.DatabaseFeatureStatusActivated},
+			{Name: "force-ssl", Status: scalingoapi.DatabaseFeatureStatusPending},
+			{Name: "publicly-available", Status: scalingoapi.DatabaseFeatureStatusFailed},
+		})
+
+		require.Equal(t, map[string]bool{
+			"redis-aof":          true,
+			"force-ssl":          true,
+			"publicly-available": false,
+		}, features)
+	})
+}
+
+func TestToDatabase_MongoDB(t *testing.T) {
+	t.Run("it converts the replica set and its instances", func(t *testing.T) {
+		db := scalingoapi.DatabaseNG{
+			ID: "db_id",
+			Database: scalingoapi.Database{
+				ID:               "db_id",
+				TypeName:         "mongodb",
+				Status:           scalingoapi.DatabaseStatusRunning,
+				MongoReplSetName: "my-rs",
+				Instances: []scalingoapi.Instance{
+					{Hostname: "node-1", Port: 27017, Type: scalingoapi.InstanceTypeDBNode},
+					{Hostname: "node-2", Port: 27017, Type: scalingoapi.InstanceTypeDBNode},
+				},
+			},
+		}
+
+		res, err := ToDatabase(t.Context(), db)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.DatabaseTypeMongoDB, res.Type)
+		require.Equal(t, "my-rs", res.MongoReplSetName)
+		require.Equal(t, []domain.DatabaseInstance{
+			{Hostname: "node-1", Port: 27017, Type: domain.DatabaseInstanceTypeDBNode},
+			{Hostname: "node-2", Port: 27017, Type: domain.DatabaseInstanceTypeDBNode},
+		}, res.Instances)
+	})
+}
+
+func TestToScalingoAppAddonProviderID(t *testing.T) {
+	t.Run("it returns the addon provider of the database type", func(t *testing.T) {
+		providerID, err := ToScalingoAppAddonProviderID(domain.DatabaseTypePostgreSQL)
+
+		require.NoError(t, err)
+		require.Equal(t, postgresqlAppAddonProviderID, providerID)
+	})
+
+	t.Run("it fails with an unknown database type", func(t *testing.T) {
+		_, err := ToScalingoAppAddonProviderID("whatever")
+
+		require.ErrorContains(t, err, "no matching addon provider")
+	})
+}
+
+func TestAddonToDatabase(t *testing.T) {
+	addon := scalingoapi.Addon{
+		ID:            "addon_id",
+		ResourceID:    "my-app-1234",
+		Status:        scalingoapi.AddonStatusRunning,
+		Plan:          &scalingoapi.Plan{ID: "plan_id", Name: "postgresql-starter-512"},
+		AddonProvider: &scalingoapi.AddonProvider{ID: "postgresql"},
+	}
+
+	t.Run("it converts the addon of a provisioning database", func(t *testing.T) {
+		provisioningAddon := addon
+		provisioningAddon.Status = scalingoapi.AddonStatusProvisioning
+
+		res, err := AddonToDatabase(t.Context(), "app_id", provisioningAddon, scalingoapi.Database{})
+
+		require.NoError(t, err)
+		require.Equal(t, domain.Database{
+			ID:         "addon_id",
+			AppID:      "app_id",
+			AddonID:    "addon_id",
+			Name:       "my-app-1234",
+			Technology: "postgresql",
+			Status:     domain.DatabaseStatusProvisioning,
+			Plan:       "postgresql-starter-512",
+		}, res)
+	})
+
+	t.Run("it prefers the status of the database", func(t *testing.T) {
+		db := scalingoapi.Database{
+			ID:              "db_id",
+			TypeName:        "postgresql",
+			Status:          scalingoapi.DatabaseStatusUpdating,
+			ReadableVersion: "17.5.0",
+		}
+
+		res, err := AddonToDatabase(t.Context(), "app_id", addon, db)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.DatabaseTypePostgreSQL, res.Type)
+		require.Equal(t, domain.DatabaseStatusProvisioning, res.Status)
+		require.Equal(t, "17.5.0", res.Version)
+	})
+
+	t.Run("it fails with an unknown addon status", func(t *testing.T) {
+		unknownAddon := addon
+		unknownAddon.Status = "whatever"
+
+		_, err := AddonToDatabase(t.Context(), "app_id", unknownAddon, scalingoapi.Database{})
+
+		require.ErrorContains(t, err, "unknown addon status")
+	})
+}
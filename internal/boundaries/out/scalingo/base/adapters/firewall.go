@@ -2,24 +2,63 @@ package adapters
 
 import (
 	"context"
+	"regexp"
 
 	scalingoapi "github.com/Scalingo/go-scalingo/v11"
 	errors "github.com/Scalingo/go-utils/errors/v3"
 	"github.com/Scalingo/scalingo-operator/internal/domain"
 )
 
+// Scalingo firewall rules have no metadata: the owner of a rule is marked at the end of its label,
+// e.g. "Office VPN [managed-by:scalingo-operator]".
+var managedByLabelRegexp = regexp.MustCompile(`^(?:(.*) )?\[managed-by:([^\]]+)\]$`)
+
 func ToFirewallRule(ctx context.Context, rule scalingoapi.FirewallRule) (domain.FirewallRule, error) {
 	ruleType, err := toFirewallRuleType(ctx, rule.Type)
 	if err != nil {
 		return domain.FirewallRule{}, errors.Wrap(ctx, err, "to firewall rule type")
 	}
-	return domain.FirewallRule{
-		ID:      rule.ID,
-		Type:    ruleType,
-		CIDR:    rule.CIDR,
-		Label:   rule.Label,
-		RangeID: rule.RangeID,
-	}, nil
+
+	label, managedBy := rule.Label, ""
+	matches := managedByLabelRegexp.FindStringSubmatch(rule.Label)
+	if matches != nil {
+		label, managedBy = matches[1], matches[2]
+	}
+	newRule := domain.FirewallRule{
+		ID:        rule.ID,
+		Type:      ruleType,
+		CIDR:      rule.CIDR,
+		Label:     label,
+		RangeID:   rule.RangeID,
+		ManagedBy: managedBy,
+	}
+
+	// Compare the ranges in canonical form with the expected rules.
+	normalizedRule, err := newRule.Normalize()
+	if err != nil {
+		return domain.FirewallRule{}, errors.Wrap(ctx, err, "normalize firewall rule")
+	}
+	return normalizedRule, nil
+}
+
+// ToScalingoFirewallRuleLabel returns the rule label, marked with its owner.
+func ToScalingoFirewallRuleLabel(rule domain.FirewallRule) string {
+	if rule.ManagedBy == "" {
+		return rule.Label
+	}
+
+	marker := "[managed-by:" + rule.ManagedBy + "]"
+	if rule.Label == "" {
+		return marker
+	}
+	return rule.Label + " " + marker
+}
+
+func ToFirewallManagedRange(managedRange scalingoapi.FirewallManagedRange) domain.FirewallManagedRange {
+	return domain.FirewallManagedRange{
+		ID:   managedRange.ID,
+		Name: managedRange.Name,
+	}
 }
 
 func toFirewallRuleType(ctx context.Context, ruleType scalingoapi.FirewallRuleType) (domain.FirewallRuleType, error) {
@@ -52,6 +52,20 @@ func TestToFirewallRule(t *testing.T) {
 		require.Equal(t, expected, result)
 	})
 
+	t.Run("it reads the owner marked in the label", func(t *testing.T) {
+		for label, expected := range map[string]domain.FirewallRule{
+			"Office VPN [managed-by:scalingo-operator]": {Label: "Office VPN", ManagedBy: domain.FirewallRuleManagedByOperator},
+			"[managed-by:scalingo-operator]":            {ManagedBy: domain.FirewallRuleManagedByOperator},
+			"Office [VPN]":                              {Label: "Office [VPN]"},
+		} {
+			result, err := ToFirewallRule(t.Context(), scalingoapi.FirewallRule{Type: scalingoapi.FirewallRuleTypeCustomRange, Label: label})
+
+			require.NoError(t, err)
+			require.Equal(t, expected.Label, result.Label, label)
+			require.Equal(t, expected.ManagedBy, result.ManagedBy, label)
+		}
+	})
+
 	t.Run("it fails converting firewall rule with unknown range", func(t *testing.T) {
 		scalingoRule := scalingoapi.FirewallRule{
 			ID:    "rule-123",
@@ -132,3 +146,13 @@ func TestToScalingoFirewallRuleType(t *testing.T) {
 		})
 	}
 }
+
+func TestToScalingoFirewallRuleLabel(t *testing.T) {
+	require.Equal(t, "Office VPN", ToScalingoFirewallRuleLabel(domain.FirewallRule{Label: "Office VPN"}))
+	require.Equal(t, "Office VPN [managed-by:scalingo-operator]", ToScalingoFirewallRuleLabel(domain.FirewallRule{
+		Label: "Office VPN", ManagedBy: domain.FirewallRuleManagedByOperator,
+	}))
+	require.Equal(t, "[managed-by:scalingo-operator]", ToScalingoFirewallRuleLabel(domain.FirewallRule{
+		ManagedBy: domain.FirewallRuleManagedByOperator,
+	}))
+}
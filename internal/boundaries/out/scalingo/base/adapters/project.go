@@ -0,0 +1,23 @@
+package adapters
+
+import (
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func ToProject(project scalingoapi.Project) domain.Project {
+	return domain.Project{
+		ID:      project.ID,
+		Name:    project.Name,
+		Default: project.Default,
+	}
+}
+
+func ToProjectPrivateNetwork(privateNetwork scalingoapi.ProjectPrivateNetwork) domain.ProjectPrivateNetwork {
+	return domain.ProjectPrivateNetwork{
+		ID:          privateNetwork.ID,
+		Subnet:      privateNetwork.Subnet,
+		Gateway:     privateNetwork.Gateway,
+		MaxIPsCount: privateNetwork.MaxIPsCount,
+	}
+}
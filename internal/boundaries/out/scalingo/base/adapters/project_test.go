@@ -0,0 +1,37 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestToProject(t *testing.T) {
+	t.Run("it converts the project", func(t *testing.T) {
+		project := ToProject(scalingoapi.Project{ID: "project-123", Name: "my-project", Default: true})
+
+		require.Equal(t, domain.Project{ID: "project-123", Name: "my-project", Default: true}, project)
+	})
+}
+
+func TestToProjectPrivateNetwork(t *testing.T) {
+	t.Run("it converts the project private network", func(t *testing.T) {
+		privateNetwork := ToProjectPrivateNetwork(scalingoapi.ProjectPrivateNetwork{
+			ID:          "pn-123",
+			Subnet:      "10.240.0.0/24",
+			Gateway:     "10.240.0.1",
+			MaxIPsCount: 253,
+			UsedIPs:     []string{"10.240.0.2", "10.240.0.3"},
+		})
+
+		require.Equal(t, domain.ProjectPrivateNetwork{
+			ID:          "pn-123",
+			Subnet:      "10.240.0.0/24",
+			Gateway:     "10.240.0.1",
+			MaxIPsCount: 253,
+		}, privateNetwork)
+	})
+}
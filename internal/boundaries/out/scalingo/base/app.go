@@ -3,9 +3,72 @@ package scalingo
 import (
 	"context"
 
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
 	errors "github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/base/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
 )
 
+func (c *client) CreateApp(ctx context.Context, app domain.App) (domain.App, error) {
+	newApp, err := c.scClient.AppsCreate(ctx, scalingoapi.AppsCreateOpts{
+		Name:      app.Name,
+		ProjectID: app.ProjectID,
+	})
+	if err != nil {
+		return domain.App{}, errors.Wrap(ctx, err, "create app")
+	}
+	return adapters.ToApp(*newApp), nil
+}
+
+func (c *client) GetApp(ctx context.Context, appID string) (domain.App, error) {
+	app, err := c.scClient.AppsShow(ctx, appID)
+	if isNotFoundError(err) {
+		return domain.App{}, errors.Wrap(ctx, domain.ErrApplicationNotFound, "get app")
+	} else if err != nil {
+		return domain.App{}, errors.Wrap(ctx, err, "get app")
+	}
+	return adapters.ToApp(*app), nil
+}
+
+func (c *client) DeleteApp(ctx context.Context, app domain.App) error {
+	err := c.scClient.AppsDestroy(ctx, app.ID, app.Name)
+	if isNotFoundError(err) {
+		return errors.Wrap(ctx, domain.ErrApplicationNotFound, "delete app")
+	} else if err != nil {
+		return errors.Wrap(ctx, err, "delete app")
+	}
+	return nil
+}
+
+func (c *client) SetAppStack(ctx context.Context, appID, stackID string) error {
+	_, err := c.scClient.AppsSetStack(ctx, appID, stackID)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "set app stack %s", stackID)
+	}
+	return nil
+}
+
+func (c *client) SetAppProject(ctx context.Context, appID, projectID string) error {
+	_, err := c.scClient.AppsSetProject(ctx, appID, projectID)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "set app project %s", projectID)
+	}
+	return nil
+}
+
+func (c *client) ListStacks(ctx context.Context) ([]domain.Stack, error) {
+	stacks, err := c.scClient.StacksList(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "list stacks")
+	}
+
+	res := make([]domain.Stack, 0, len(stacks))
+	for _, stack := range stacks {
+		res = append(res, adapters.ToStack(stack))
+	}
+	return res, nil
+}
+
 func (c *client) FindApplicationVariable(ctx context.Context, appID, varName string) (string, error) {
 	variables, err := c.scClient.VariablesListWithoutAlias(ctx, appID)
 	if err != nil {
@@ -14,8 +77,52 @@ func (c *client) FindApplicationVariable(ctx context.Context, appID, varName str
 
 	variable, found := variables.Contains(varName)
 	if !found {
-		return "", errors.Newf(ctx, "variable %s not found", varName)
+		return "", errors.Wrapf(ctx, domain.ErrApplicationVariableNotFound, "variable %s", varName)
 	}
 
 	return variable.Value, nil
 }
+
+func (c *client) ListAppVariables(ctx context.Context, appID string) ([]domain.AppVariable, error) {
+	variables, err := c.scClient.VariablesListWithoutAlias(ctx, appID)
+	if isNotFoundError(err) {
+		return nil, errors.Wrap(ctx, domain.ErrApplicationNotFound, "list app variables")
+	} else if err != nil {
+		return nil, errors.Wrap(ctx, err, "list app variables")
+	}
+	return adapters.ToAppVariables(variables), nil
+}
+
+func (c *client) SetAppVariables(ctx context.Context, appID string, variables []domain.AppVariable) error {
+	_, err := c.scClient.VariableMultipleSet(ctx, appID, adapters.ToScalingoVariables(variables))
+	if err != nil {
+		return errors.Wrap(ctx, err, "set app variables")
+	}
+	return nil
+}
+
+func (c *client) UnsetAppVariable(ctx context.Context, appID, variableID string) error {
+	err := c.scClient.VariableUnset(ctx, appID, variableID)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "unset app variable %s", variableID)
+	}
+	return nil
+}
+
+func (c *client) ListAppContainers(ctx context.Context, appID string) ([]domain.AppContainer, error) {
+	containerTypes, err := c.scClient.AppsContainerTypes(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "list app containers")
+	}
+	return adapters.ToAppContainers(containerTypes), nil
+}
+
+func (c *client) ScaleApp(ctx context.Context, appID string, containers []domain.AppContainer) error {
+	_, _, err := c.scClient.AppsScale(ctx, appID, &scalingoapi.AppsScaleParams{
+		Containers: adapters.ToScalingoContainerTypes(containers),
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, "scale app")
+	}
+	return nil
+}
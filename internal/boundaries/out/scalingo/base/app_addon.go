@@ -0,0 +1,154 @@
+package scalingo
+
+import (
+	"context"
+	"net/http"
+
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+	httpclient "github.com/Scalingo/go-scalingo/v11/http"
+	errors "github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/base/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// appAddonClient manages the databases as addons of an existing application, e.g. on shared starter plans,
+// rather than as dedicated resources databases. The database ID is the addon ID.
+//
+// The addons have neither endpoints nor net peerings: they are listed empty, and their management fails.
+type appAddonClient struct {
+	*client
+	appID string
+}
+
+// NewAppAddonClient returns a client managing the databases as addons of the given application.
+func NewAppAddonClient(ctx context.Context, apiToken, region, appID string) (scalingo.Client, error) {
+	if appID == "" {
+		return nil, errors.New(ctx, "empty app id")
+	}
+
+	c, err := newClient(ctx, apiToken, region)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appAddonClient{
+		client: c,
+		appID:  appID,
+	}, nil
+}
+
+func (c *appAddonClient) CreateDatabase(ctx context.Context, db domain.Database) (domain.Database, error) {
+	addonProviderID, err := adapters.ToScalingoAppAddonProviderID(db.Type)
+	if err != nil {
+		return domain.Database{}, errors.Wrap(ctx, err, "create addon")
+	}
+
+	planID, err := c.findPlanID(ctx, addonProviderID, db.Plan)
+	if err != nil {
+		return domain.Database{}, errors.Wrapf(ctx, err, "invalid addon plan %s", db.Plan)
+	}
+
+	res, err := c.scClient.AddonProvision(ctx, c.appID, scalingoapi.AddonProvisionParams{
+		AddonProviderID: addonProviderID,
+		PlanID:          planID,
+	})
+	if err != nil {
+		return domain.Database{}, errors.Wrap(ctx, err, "create addon")
+	}
+	return adapters.AddonToDatabase(ctx, c.appID, res.Addon, scalingoapi.Database{})
+}
+
+func (c *appAddonClient) GetDatabase(ctx context.Context, dbID string) (domain.Database, error) {
+	addon, err := c.scClient.AddonShow(ctx, c.appID, dbID)
+	if isNotFoundError(err) {
+		return domain.Database{}, errors.Wrap(ctx, ErrDatabaseNotFound, "get addon")
+	} else if err != nil {
+		return domain.Database{}, errors.Wrap(ctx, err, "get addon")
+	}
+
+	// The database details are served once the addon is provisioned.
+	var currentDB scalingoapi.Database
+	if addon.Status == scalingoapi.AddonStatusRunning {
+		currentDB, err = c.scClient.DatabaseShow(ctx, c.appID, addon.ID)
+		if err != nil {
+			return domain.Database{}, errors.Wrap(ctx, err, "get addon database")
+		}
+	}
+
+	db, err := adapters.AddonToDatabase(ctx, c.appID, addon, currentDB)
+	if err != nil {
+		return domain.Database{}, errors.Wrap(ctx, err, "to database")
+	}
+	return db, nil
+}
+
+func (c *appAddonClient) DeleteDatabase(ctx context.Context, dbID string) error {
+	err := c.scClient.AddonDestroy(ctx, c.appID, dbID)
+	if err != nil {
+		return errors.Wrap(ctx, err, "delete addon")
+	}
+	return nil
+}
+
+// The firewall rules are managed through the database API of the application hosting the addon.
+
+func (c *appAddonClient) CreateFirewallRule(ctx context.Context, _, addonID string, rule domain.FirewallRule) error {
+	return c.client.CreateFirewallRule(ctx, c.appID, addonID, rule)
+}
+
+func (c *appAddonClient) ListFirewallRules(ctx context.Context, _, addonID string) ([]domain.FirewallRule, error) {
+	return c.client.ListFirewallRules(ctx, c.appID, addonID)
+}
+
+func (c *appAddonClient) DeleteFirewallRule(ctx context.Context, _, addonID, firewallRuleID string) error {
+	return c.client.DeleteFirewallRule(ctx, c.appID, addonID, firewallRuleID)
+}
+
+func (c *appAddonClient) ListFirewallManagedRanges(ctx context.Context, _, addonID string) ([]domain.FirewallManagedRange, error) {
+	return c.client.ListFirewallManagedRanges(ctx, c.appID, addonID)
+}
+
+func (c *appAddonClient) ListDatabaseEndpoints(_ context.Context, _ string) ([]domain.DatabaseEndpoint, error) {
+	return nil, nil
+}
+
+func (c *appAddonClient) GetDatabaseNetworkConfiguration(ctx context.Context, _ string) (domain.DatabaseNetworkConfiguration, error) {
+	return domain.DatabaseNetworkConfiguration{}, errors.Wrap(ctx, domain.ErrNotImplemented, "get addon network configuration")
+}
+
+func (c *appAddonClient) CreateDatabaseNetPeering(ctx context.Context, _, _ string) (domain.DatabaseNetPeering, error) {
+	return domain.DatabaseNetPeering{}, errors.Wrap(ctx, domain.ErrNotImplemented, "create addon net peering")
+}
+
+func (c *appAddonClient) ListDatabaseNetPeerings(_ context.Context, _ string) ([]domain.DatabaseNetPeering, error) {
+	return nil, nil
+}
+
+func (c *appAddonClient) DeleteDatabaseNetPeering(ctx context.Context, _, _ string) error {
+	return errors.Wrap(ctx, domain.ErrNotImplemented, "delete addon net peering")
+}
+
+func (c *appAddonClient) SetDatabaseProject(ctx context.Context, _ domain.Database, _ string) error {
+	return errors.Wrap(ctx, domain.ErrNotImplemented, "set addon project")
+}
+
+func isNotFoundError(err error) bool {
+	var requestFailedErr *httpclient.RequestFailedError
+	return errors.As(err, &requestFailedErr) && requestFailedErr.Code == http.StatusNotFound
+}
+
+// isRejectedError returns whether the API refused the request itself, which fails the same way when retried.
+func isRejectedError(err error) bool {
+	var requestFailedErr *httpclient.RequestFailedError
+	if !errors.As(err, &requestFailedErr) {
+		return false
+	}
+
+	switch requestFailedErr.Code {
+	case http.StatusBadRequest, http.StatusForbidden, http.StatusConflict, http.StatusUnprocessableEntity:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,162 @@
+package scalingo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func newTestAppAddonClient(t *testing.T, handler http.HandlerFunc) *appAddonClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	scClient, err := scalingoapi.New(t.Context(), scalingoapi.ClientConfig{
+		APIEndpoint: server.URL,
+		Region:      "",
+	})
+	require.NoError(t, err)
+
+	return &appAddonClient{
+		client: &client{scClient: scClient},
+		appID:  "my-app",
+	}
+}
+
+func TestNewAppAddonClient(t *testing.T) {
+	t.Run("it fails because of empty app id", func(t *testing.T) {
+		scClient, err := NewAppAddonClient(t.Context(), "token", "", "")
+
+		require.EqualError(t, err, "empty app id")
+		require.Nil(t, scClient)
+	})
+}
+
+func TestAppAddonClient_CreateDatabase(t *testing.T) {
+	t.Run("it provisions the addon on the app", func(t *testing.T) {
+		var payload map[string]map[string]any
+		client := newTestAppAddonClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/v1/addon_providers/postgresql/plans":
+				_, writeErr := w.Write([]byte(`{"plans":[{"id":"plan-512","name":"postgresql-starter-512"}]}`))
+				assert.NoError(t, writeErr)
+			case "/v1/apps/my-app/addons":
+				assert.Equal(t, http.MethodPost, r.Method)
+				decodeErr := json.NewDecoder(r.Body).Decode(&payload)
+				assert.NoError(t, decodeErr)
+
+				w.WriteHeader(http.StatusCreated)
+				_, writeErr := w.Write([]byte(`{"addon":{"id":"addon-123","resource_id":"my-app-1234","status":"provisioning","plan":{"id":"plan-512","name":"postgresql-starter-512"},"addon_provider":{"id":"postgresql"}}}`))
+				assert.NoError(t, writeErr)
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		})
+
+		db, err := client.CreateDatabase(t.Context(), domain.Database{
+			Name: "my-db",
+			Type: domain.DatabaseTypePostgreSQL,
+			Plan: "postgresql-starter-512",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "postgresql", payload["addon"]["addon_provider_id"])
+		assert.Equal(t, "plan-512", payload["addon"]["plan_id"])
+		require.Equal(t, "addon-123", db.ID)
+		require.Equal(t, "my-app", db.AppID)
+		require.Equal(t, domain.DatabaseStatusProvisioning, db.Status)
+	})
+
+	t.Run("it fails with an unknown plan", func(t *testing.T) {
+		client := newTestAppAddonClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, writeErr := w.Write([]byte(`{"plans":[]}`))
+			assert.NoError(t, writeErr)
+		})
+
+		_, err := client.CreateDatabase(t.Context(), domain.Database{
+			Type: domain.DatabaseTypePostgreSQL,
+			Plan: "postgresql-starter-512",
+		})
+
+		require.ErrorContains(t, err, "invalid addon plan postgresql-starter-512")
+	})
+}
+
+func TestAppAddonClient_GetDatabase(t *testing.T) {
+	t.Run("it returns the addon while provisioning", func(t *testing.T) {
+		client := newTestAppAddonClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/apps/my-app/addons/addon-123", r.URL.Path)
+
+			_, writeErr := w.Write([]byte(`{"addon":{"id":"addon-123","status":"provisioning","plan":{"name":"postgresql-starter-512"},"addon_provider":{"id":"postgresql"}}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		db, err := client.GetDatabase(t.Context(), "addon-123")
+
+		require.NoError(t, err)
+		require.Equal(t, "addon-123", db.AddonID)
+		require.Equal(t, "postgresql", db.Technology)
+		require.Equal(t, "postgresql-starter-512", db.Plan)
+		require.Equal(t, domain.DatabaseStatusProvisioning, db.Status)
+	})
+
+	t.Run("it returns database not found", func(t *testing.T) {
+		client := newTestAppAddonClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, writeErr := w.Write([]byte(`{"error":"not found"}`))
+			assert.NoError(t, writeErr)
+		})
+
+		_, err := client.GetDatabase(t.Context(), "addon-123")
+
+		require.ErrorIs(t, err, ErrDatabaseNotFound)
+	})
+}
+
+func TestAppAddonClient_DeleteDatabase(t *testing.T) {
+	t.Run("it destroys the addon of the app", func(t *testing.T) {
+		client := newTestAppAddonClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "/v1/apps/my-app/addons/addon-123", r.URL.Path)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		err := client.DeleteDatabase(t.Context(), "addon-123")
+
+		require.NoError(t, err)
+	})
+}
+
+func TestAppAddonClient_Networking(t *testing.T) {
+	client := &appAddonClient{appID: "my-app"}
+
+	t.Run("it lists no endpoint and no net peering", func(t *testing.T) {
+		endpoints, err := client.ListDatabaseEndpoints(t.Context(), "addon-123")
+		require.NoError(t, err)
+		require.Empty(t, endpoints)
+
+		netPeerings, err := client.ListDatabaseNetPeerings(t.Context(), "addon-123")
+		require.NoError(t, err)
+		require.Empty(t, netPeerings)
+	})
+
+	t.Run("it fails to create a net peering", func(t *testing.T) {
+		_, err := client.CreateDatabaseNetPeering(t.Context(), "addon-123", "pcx-123")
+
+		require.ErrorIs(t, err, domain.ErrNotImplemented)
+	})
+
+	t.Run("it fails to move the addon to another project", func(t *testing.T) {
+		err := client.SetDatabaseProject(t.Context(), domain.Database{ID: "addon-123"}, "prj-123")
+
+		require.ErrorIs(t, err, domain.ErrNotImplemented)
+	})
+}
@@ -0,0 +1,150 @@
+package scalingo
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestClient_GetApp(t *testing.T) {
+	t.Run("it gets the app", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/apps/app-123", r.URL.Path)
+
+			_, writeErr := w.Write([]byte(`{"app":{"id":"app-123","name":"my-app","url":"https://my-app.osc-fr1.scalingo.io","stack_id":"st-123","project":{"id":"prj-123"}}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		app, err := client.GetApp(t.Context(), "app-123")
+
+		require.NoError(t, err)
+		require.Equal(t, domain.App{
+			ID:        "app-123",
+			Name:      "my-app",
+			ProjectID: "prj-123",
+			URL:       "https://my-app.osc-fr1.scalingo.io",
+			Stack:     "st-123",
+		}, app)
+	})
+
+	t.Run("it fails when the app is not found", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, writeErr := w.Write([]byte(`{"error":"not found"}`))
+			assert.NoError(t, writeErr)
+		})
+
+		_, err := client.GetApp(t.Context(), "app-123")
+
+		require.ErrorIs(t, err, domain.ErrApplicationNotFound)
+	})
+}
+
+func TestClient_DeleteApp(t *testing.T) {
+	t.Run("it deletes the app with its current name", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "/v1/apps/app-123", r.URL.Path)
+			assert.Equal(t, "my-app", r.URL.Query().Get("current_name"))
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		err := client.DeleteApp(t.Context(), domain.App{ID: "app-123", Name: "my-app"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it fails when the app is not found", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, writeErr := w.Write([]byte(`{"error":"not found"}`))
+			assert.NoError(t, writeErr)
+		})
+
+		err := client.DeleteApp(t.Context(), domain.App{ID: "app-123", Name: "my-app"})
+
+		require.ErrorIs(t, err, domain.ErrApplicationNotFound)
+	})
+}
+
+func TestClient_ListAppVariables(t *testing.T) {
+	t.Run("it lists the variables", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/apps/app-123/variables", r.URL.Path)
+
+			_, writeErr := w.Write([]byte(`{"variables":[{"id":"var-123","name":"LOG_LEVEL","value":"debug"}]}`))
+			assert.NoError(t, writeErr)
+		})
+
+		variables, err := client.ListAppVariables(t.Context(), "app-123")
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.AppVariable{{ID: "var-123", Name: "LOG_LEVEL", Value: "debug"}}, variables)
+	})
+
+	t.Run("it fails when the app is not found", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, writeErr := w.Write([]byte(`{"error":"not found"}`))
+			assert.NoError(t, writeErr)
+		})
+
+		_, err := client.ListAppVariables(t.Context(), "app-123")
+
+		require.ErrorIs(t, err, domain.ErrApplicationNotFound)
+	})
+}
+
+func TestClient_SetAppVariables(t *testing.T) {
+	t.Run("it sets the variables at once", func(t *testing.T) {
+		var payload map[string][]map[string]any
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/v1/apps/app-123/variables", r.URL.Path)
+
+			decodeErr := json.NewDecoder(r.Body).Decode(&payload)
+			assert.NoError(t, decodeErr)
+
+			_, writeErr := w.Write([]byte(`{"variables":[]}`))
+			assert.NoError(t, writeErr)
+		})
+
+		err := client.SetAppVariables(t.Context(), "app-123", []domain.AppVariable{{Name: "LOG_LEVEL", Value: "debug"}})
+
+		require.NoError(t, err)
+		require.Len(t, payload["variables"], 1)
+		assert.Equal(t, "LOG_LEVEL", payload["variables"][0]["name"])
+		assert.Equal(t, "debug", payload["variables"][0]["value"])
+	})
+}
+
+func TestClient_ScaleApp(t *testing.T) {
+	t.Run("it scales the container types", func(t *testing.T) {
+		var payload map[string][]map[string]any
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/v1/apps/app-123/scale", r.URL.Path)
+
+			decodeErr := json.NewDecoder(r.Body).Decode(&payload)
+			assert.NoError(t, decodeErr)
+
+			w.WriteHeader(http.StatusAccepted)
+			_, writeErr := w.Write([]byte(`{"containers":[{"name":"web","amount":2,"size":"M"}]}`))
+			assert.NoError(t, writeErr)
+		})
+
+		err := client.ScaleApp(t.Context(), "app-123", []domain.AppContainer{{Name: "web", Amount: 2, Size: "M"}})
+
+		require.NoError(t, err)
+		require.Len(t, payload["containers"], 1)
+		assert.Equal(t, "web", payload["containers"][0]["name"])
+		assert.InDelta(t, 2, payload["containers"][0]["amount"], 0)
+		assert.Equal(t, "M", payload["containers"][0]["size"])
+	})
+}
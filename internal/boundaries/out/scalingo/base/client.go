@@ -19,6 +19,14 @@ type client struct {
 }
 
 func NewClient(ctx context.Context, apiToken, region string) (scalingo.Client, error) {
+	c, err := newClient(ctx, apiToken, region)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func newClient(ctx context.Context, apiToken, region string) (*client, error) {
 	if apiToken == "" {
 		return nil, errors.New(ctx, "empty api token")
 	}
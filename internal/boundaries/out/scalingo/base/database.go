@@ -2,8 +2,11 @@ package scalingo
 
 import (
 	"context"
+	"io"
+	"net/http"
 
 	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+	httpclient "github.com/Scalingo/go-scalingo/v11/http"
 	"github.com/Scalingo/go-utils/errors/v3"
 	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/base/adapters"
 	"github.com/Scalingo/scalingo-operator/internal/domain"
@@ -81,3 +84,73 @@ func (c *client) DeleteDatabase(ctx context.Context, dbID string) error {
 	}
 	return nil
 }
+
+// SetDatabaseProject moves the database to the given project, through the application holding it. The moves
+// refused by the API, e.g. because of a private network mismatch, are reported as rejected.
+func (c *client) SetDatabaseProject(ctx context.Context, db domain.Database, projectID string) error {
+	_, err := c.scClient.AppsSetProject(ctx, db.AppID, projectID)
+	if isRejectedError(err) {
+		return errors.Wrapf(ctx, domain.ErrDatabaseProjectMoveRejected, "set database project %s: %v", projectID, err)
+	} else if err != nil {
+		return errors.Wrapf(ctx, err, "set database project %s", projectID)
+	}
+	return nil
+}
+
+// GetDatabaseCACertificate returns the PEM encoded certificate of the authority signing the database server
+// certificates. The database API publishes a single CA certificate for all the databases of the region.
+func (c *client) GetDatabaseCACertificate(ctx context.Context, appID, addonID string) (string, error) {
+	res, err := c.scClient.DBAPI(appID, addonID).Do(ctx, &httpclient.APIRequest{
+		Method:   http.MethodGet,
+		Endpoint: "/ca_certificate",
+		Expected: httpclient.Statuses{http.StatusOK},
+	})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "get database ca certificate")
+	}
+	defer res.Body.Close()
+
+	caCertificate, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "read database ca certificate")
+	}
+	return string(caCertificate), nil
+}
+
+// databaseDashboardsRes is the part of the database API database resource holding the URL of its Kibana or
+// OpenSearch Dashboards, not mapped by go-scalingo.
+type databaseDashboardsRes struct {
+	Database struct {
+		DashboardsURL string `json:"dashboards_url"`
+	} `json:"database"`
+}
+
+// GetDatabaseDashboardsURL returns the URL of the Kibana or OpenSearch Dashboards of the database, read from the
+// database API database resource, empty when the database does not provide any.
+func (c *client) GetDatabaseDashboardsURL(ctx context.Context, appID, addonID string) (string, error) {
+	var res databaseDashboardsRes
+	err := c.scClient.DBAPI(appID, addonID).ResourceGet(ctx, "databases", addonID, nil, &res)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "get database dashboards url")
+	}
+	return res.Database.DashboardsURL, nil
+}
+
+func (c *client) EnableDatabaseFeature(ctx context.Context, appID, addonID, feature string) error {
+	res, err := c.scClient.DatabaseEnableFeature(ctx, appID, addonID, feature)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "enable database feature %s", feature)
+	}
+	if res.Status == scalingoapi.DatabaseFeatureStatusFailed {
+		return errors.Newf(ctx, "enable database feature %s: %s", feature, res.Message)
+	}
+	return nil
+}
+
+func (c *client) DisableDatabaseFeature(ctx context.Context, appID, addonID, feature string) error {
+	_, err := c.scClient.DatabaseDisableFeature(ctx, appID, addonID, feature)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "disable database feature %s", feature)
+	}
+	return nil
+}
@@ -51,6 +51,7 @@ func (c *client) CreateDatabaseNetPeering(ctx context.Context, dbID, outscaleNet
 	return domain.DatabaseNetPeering{
 		ID:                   netPeering.ID,
 		OutscaleNetPeeringID: netPeering.OutscaleNetPeeringID,
+		Status:               domain.DatabaseNetPeeringStatus(netPeering.Status),
 	}, nil
 }
 
@@ -65,6 +66,7 @@ func (c *client) ListDatabaseNetPeerings(ctx context.Context, dbID string) ([]do
 		result = append(result, domain.DatabaseNetPeering{
 			ID:                   netPeering.ID,
 			OutscaleNetPeeringID: netPeering.OutscaleNetPeeringID,
+			Status:               domain.DatabaseNetPeeringStatus(netPeering.Status),
 		})
 	}
 	return result, nil
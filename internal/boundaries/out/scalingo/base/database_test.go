@@ -93,3 +93,158 @@ func TestClient_UpdateDatabasePlan(t *testing.T) {
 		require.ErrorIs(t, err, domain.ErrNothingToBeDone)
 	})
 }
+
+func TestClient_SetDatabaseProject(t *testing.T) {
+	db := domain.Database{ID: "db-123", AppID: "app-123"}
+
+	t.Run("it moves the database app to the project", func(t *testing.T) {
+		var payload map[string]any
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/v1/apps/app-123", r.URL.Path)
+
+			decodeErr := json.NewDecoder(r.Body).Decode(&payload)
+			assert.NoError(t, decodeErr)
+
+			_, writeErr := w.Write([]byte(`{"app":{"id":"app-123","project":{"id":"prj-456"}}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		err := client.SetDatabaseProject(t.Context(), db, "prj-456")
+
+		require.NoError(t, err)
+		assert.Equal(t, "prj-456", payload["project_id"])
+	})
+
+	t.Run("it reports the move rejected by the API", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, writeErr := w.Write([]byte(`{"errors":{"project_id":["private network mismatch"]}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		err := client.SetDatabaseProject(t.Context(), db, "prj-456")
+
+		require.ErrorIs(t, err, domain.ErrDatabaseProjectMoveRejected)
+		require.ErrorContains(t, err, "private network mismatch")
+	})
+
+	t.Run("it fails with a server error", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		err := client.SetDatabaseProject(t.Context(), db, "prj-456")
+
+		require.Error(t, err)
+		require.NotErrorIs(t, err, domain.ErrDatabaseProjectMoveRejected)
+	})
+}
+
+func TestClient_GetDatabaseCACertificate(t *testing.T) {
+	const caCertificate = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+	newCACertificateClient := func(t *testing.T, caHandler http.HandlerFunc) client {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v1/apps/app-123/addons/ad-123/token" {
+				_, writeErr := w.Write([]byte(`{"addon":{"token":"addon-token"}}`))
+				assert.NoError(t, writeErr)
+				return
+			}
+			caHandler(w, r)
+		}))
+		t.Cleanup(server.Close)
+
+		scClient, err := scalingoapi.New(t.Context(), scalingoapi.ClientConfig{
+			APIEndpoint:         server.URL,
+			DatabaseAPIEndpoint: server.URL,
+			Region:              "",
+		})
+		require.NoError(t, err)
+		return client{scClient: scClient}
+	}
+
+	t.Run("it gets the CA certificate of the region from the database API", func(t *testing.T) {
+		client := newCACertificateClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/ca_certificate", r.URL.Path)
+
+			_, writeErr := w.Write([]byte(caCertificate))
+			assert.NoError(t, writeErr)
+		})
+
+		res, err := client.GetDatabaseCACertificate(t.Context(), "app-123", "ad-123")
+
+		require.NoError(t, err)
+		require.Equal(t, caCertificate, res)
+	})
+
+	t.Run("it fails when the CA certificate is not available", func(t *testing.T) {
+		client := newCACertificateClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		_, err := client.GetDatabaseCACertificate(t.Context(), "app-123", "ad-123")
+
+		require.ErrorContains(t, err, "get database ca certificate")
+	})
+}
+
+func TestClient_GetDatabaseDashboardsURL(t *testing.T) {
+	newDashboardsClient := func(t *testing.T, databaseHandler http.HandlerFunc) client {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/v1/apps/app-123/addons/ad-123/token" {
+				_, writeErr := w.Write([]byte(`{"addon":{"token":"addon-token"}}`))
+				assert.NoError(t, writeErr)
+				return
+			}
+			databaseHandler(w, r)
+		}))
+		t.Cleanup(server.Close)
+
+		scClient, err := scalingoapi.New(t.Context(), scalingoapi.ClientConfig{
+			APIEndpoint:         server.URL,
+			DatabaseAPIEndpoint: server.URL,
+			Region:              "",
+		})
+		require.NoError(t, err)
+		return client{scClient: scClient}
+	}
+
+	t.Run("it gets the dashboards URL from the database resource", func(t *testing.T) {
+		client := newDashboardsClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/databases/ad-123", r.URL.Path)
+
+			_, writeErr := w.Write([]byte(`{"database":{"id":"ad-123","dashboards_url":"https://dashboards.example.com"}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		res, err := client.GetDatabaseDashboardsURL(t.Context(), "app-123", "ad-123")
+
+		require.NoError(t, err)
+		require.Equal(t, "https://dashboards.example.com", res)
+	})
+
+	t.Run("it returns an empty URL when the database provides no dashboards", func(t *testing.T) {
+		client := newDashboardsClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, writeErr := w.Write([]byte(`{"database":{"id":"ad-123"}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		res, err := client.GetDatabaseDashboardsURL(t.Context(), "app-123", "ad-123")
+
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("it fails when the database resource is not available", func(t *testing.T) {
+		client := newDashboardsClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		_, err := client.GetDatabaseDashboardsURL(t.Context(), "app-123", "ad-123")
+
+		require.ErrorContains(t, err, "get database dashboards url")
+	})
+}
@@ -15,10 +15,13 @@ func (c *client) CreateFirewallRule(ctx context.Context, dbID, addonID string, r
 		return errors.Wrap(ctx, err, "to scalingo firewall rule type")
 	}
 
+	// Mark the rule as created by the operator, so that the rules added from the dashboard are left alone.
+	rule.ManagedBy = domain.FirewallRuleManagedByOperator
+
 	_, err = c.scClient.Preview().FirewallRulesCreate(ctx, dbID, addonID, scalingoapi.FirewallRuleCreateParams{
 		Type:    ruleType,
 		CIDR:    rule.CIDR,
-		Label:   rule.Label,
+		Label:   adapters.ToScalingoFirewallRuleLabel(rule),
 		RangeID: rule.RangeID,
 	})
 	if err != nil {
@@ -51,3 +54,16 @@ func (c *client) DeleteFirewallRule(ctx context.Context, dbID, addonID, firewall
 	}
 	return nil
 }
+
+func (c *client) ListFirewallManagedRanges(ctx context.Context, dbID, addonID string) ([]domain.FirewallManagedRange, error) {
+	scalingoRanges, err := c.scClient.Preview().FirewallRulesGetManagedRanges(ctx, dbID, addonID)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "list firewall managed ranges")
+	}
+
+	ranges := make([]domain.FirewallManagedRange, 0, len(scalingoRanges))
+	for _, scalingoRange := range scalingoRanges {
+		ranges = append(ranges, adapters.ToFirewallManagedRange(scalingoRange))
+	}
+	return ranges, nil
+}
@@ -0,0 +1,62 @@
+package scalingo
+
+import (
+	"context"
+
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+	errors "github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/base/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func (c *client) CreateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
+	newProject, err := c.scClient.ProjectAdd(ctx, scalingoapi.ProjectAddParams{
+		Name:    project.Name,
+		Default: project.Default,
+	})
+	if err != nil {
+		return domain.Project{}, errors.Wrap(ctx, err, "create project")
+	}
+	return adapters.ToProject(newProject), nil
+}
+
+func (c *client) GetProject(ctx context.Context, projectID string) (domain.Project, error) {
+	project, err := c.scClient.ProjectGet(ctx, projectID)
+	if isNotFoundError(err) {
+		return domain.Project{}, errors.Wrap(ctx, domain.ErrProjectNotFound, "get project")
+	} else if err != nil {
+		return domain.Project{}, errors.Wrap(ctx, err, "get project")
+	}
+	return adapters.ToProject(project), nil
+}
+
+func (c *client) UpdateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
+	updatedProject, err := c.scClient.ProjectUpdate(ctx, project.ID, scalingoapi.ProjectUpdateParams{
+		Name:    &project.Name,
+		Default: &project.Default,
+	})
+	if err != nil {
+		return domain.Project{}, errors.Wrap(ctx, err, "update project")
+	}
+	return adapters.ToProject(updatedProject), nil
+}
+
+func (c *client) DeleteProject(ctx context.Context, projectID string) error {
+	err := c.scClient.ProjectDelete(ctx, projectID)
+	if isNotFoundError(err) {
+		return errors.Wrap(ctx, domain.ErrProjectNotFound, "delete project")
+	} else if err != nil {
+		return errors.Wrap(ctx, err, "delete project")
+	}
+	return nil
+}
+
+func (c *client) GetProjectPrivateNetwork(ctx context.Context, projectID string) (domain.ProjectPrivateNetwork, error) {
+	privateNetwork, err := c.scClient.ProjectPrivateNetworkGet(ctx, projectID)
+	if isNotFoundError(err) {
+		return domain.ProjectPrivateNetwork{}, errors.Wrap(ctx, domain.ErrProjectPrivateNetworkNotFound, "get project private network")
+	} else if err != nil {
+		return domain.ProjectPrivateNetwork{}, errors.Wrap(ctx, err, "get project private network")
+	}
+	return adapters.ToProjectPrivateNetwork(privateNetwork), nil
+}
@@ -0,0 +1,162 @@
+package scalingo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scalingoapi "github.com/Scalingo/go-scalingo/v11"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	scClient, err := scalingoapi.New(t.Context(), scalingoapi.ClientConfig{
+		APIEndpoint: server.URL,
+		Region:      "",
+	})
+	require.NoError(t, err)
+
+	return &client{scClient: scClient}
+}
+
+func TestClient_CreateProject(t *testing.T) {
+	t.Run("it creates the project", func(t *testing.T) {
+		var payload map[string]map[string]any
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/v1/projects", r.URL.Path)
+
+			decodeErr := json.NewDecoder(r.Body).Decode(&payload)
+			assert.NoError(t, decodeErr)
+
+			w.WriteHeader(http.StatusCreated)
+			_, writeErr := w.Write([]byte(`{"project":{"id":"prj-123","name":"my-project","default":false}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		project, err := client.CreateProject(t.Context(), domain.Project{Name: "my-project"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", payload["project"]["name"])
+		assert.Equal(t, false, payload["project"]["default"])
+		require.Equal(t, domain.Project{ID: "prj-123", Name: "my-project"}, project)
+	})
+}
+
+func TestClient_GetProject(t *testing.T) {
+	t.Run("it gets the project", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/projects/prj-123", r.URL.Path)
+
+			_, writeErr := w.Write([]byte(`{"project":{"id":"prj-123","name":"my-project","default":true}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		project, err := client.GetProject(t.Context(), "prj-123")
+
+		require.NoError(t, err)
+		require.Equal(t, domain.Project{ID: "prj-123", Name: "my-project", Default: true}, project)
+	})
+
+	t.Run("it fails when the project is not found", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, writeErr := w.Write([]byte(`{"error":"not found"}`))
+			assert.NoError(t, writeErr)
+		})
+
+		_, err := client.GetProject(t.Context(), "prj-123")
+
+		require.ErrorIs(t, err, domain.ErrProjectNotFound)
+	})
+}
+
+func TestClient_UpdateProject(t *testing.T) {
+	t.Run("it updates the name and the default flag", func(t *testing.T) {
+		var payload map[string]map[string]any
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			assert.Equal(t, "/v1/projects/prj-123", r.URL.Path)
+
+			decodeErr := json.NewDecoder(r.Body).Decode(&payload)
+			assert.NoError(t, decodeErr)
+
+			_, writeErr := w.Write([]byte(`{"project":{"id":"prj-123","name":"new-name","default":false}}`))
+			assert.NoError(t, writeErr)
+		})
+
+		project, err := client.UpdateProject(t.Context(), domain.Project{ID: "prj-123", Name: "new-name"})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "new-name", "default": false}, payload["project"])
+		require.Equal(t, domain.Project{ID: "prj-123", Name: "new-name"}, project)
+	})
+}
+
+func TestClient_DeleteProject(t *testing.T) {
+	t.Run("it deletes the project", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "/v1/projects/prj-123", r.URL.Path)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		err := client.DeleteProject(t.Context(), "prj-123")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it fails when the project is not found", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, writeErr := w.Write([]byte(`{"error":"not found"}`))
+			assert.NoError(t, writeErr)
+		})
+
+		err := client.DeleteProject(t.Context(), "prj-123")
+
+		require.ErrorIs(t, err, domain.ErrProjectNotFound)
+	})
+}
+
+func TestClient_GetProjectPrivateNetwork(t *testing.T) {
+	t.Run("it gets the project private network", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/projects/prj-123/private_network", r.URL.Path)
+
+			_, writeErr := w.Write([]byte(`{"id":"pn-123","subnet":"10.240.0.0/24","gateway":"10.240.0.1","max_ips_count":253,"used_ips_count":1}`))
+			assert.NoError(t, writeErr)
+		})
+
+		privateNetwork, err := client.GetProjectPrivateNetwork(t.Context(), "prj-123")
+
+		require.NoError(t, err)
+		require.Equal(t, domain.ProjectPrivateNetwork{
+			ID:          "pn-123",
+			Subnet:      "10.240.0.0/24",
+			Gateway:     "10.240.0.1",
+			MaxIPsCount: 253,
+		}, privateNetwork)
+	})
+
+	t.Run("it fails when the project has no private network", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, writeErr := w.Write([]byte(`{"error":"not found"}`))
+			assert.NoError(t, writeErr)
+		})
+
+		_, err := client.GetProjectPrivateNetwork(t.Context(), "prj-123")
+
+		require.ErrorIs(t, err, domain.ErrProjectPrivateNetworkNotFound)
+	})
+}
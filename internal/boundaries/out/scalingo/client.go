@@ -13,17 +13,41 @@ type Client interface {
 	GetDatabase(ctx context.Context, dbID string) (domain.Database, error)
 	UpdateDatabasePlan(ctx context.Context, db domain.Database, expectedPlan string) (domain.DatabaseStatus, error)
 	DeleteDatabase(ctx context.Context, dbID string) error
+	SetDatabaseProject(ctx context.Context, db domain.Database, projectID string) error
+	GetDatabaseCACertificate(ctx context.Context, appID, addonID string) (string, error)
+	GetDatabaseDashboardsURL(ctx context.Context, appID, addonID string) (string, error)
 	ListDatabaseEndpoints(ctx context.Context, dbID string) ([]domain.DatabaseEndpoint, error)
 	GetDatabaseNetworkConfiguration(ctx context.Context, dbID string) (domain.DatabaseNetworkConfiguration, error)
 	CreateDatabaseNetPeering(ctx context.Context, dbID, outscaleNetPeeringID string) (domain.DatabaseNetPeering, error)
 	ListDatabaseNetPeerings(ctx context.Context, dbID string) ([]domain.DatabaseNetPeering, error)
 	DeleteDatabaseNetPeering(ctx context.Context, dbID, netPeeringID string) error
+	EnableDatabaseFeature(ctx context.Context, appID, addonID, feature string) error
+	DisableDatabaseFeature(ctx context.Context, appID, addonID, feature string) error
 
 	// Firewall.
 	CreateFirewallRule(ctx context.Context, dbID, addonID string, rule domain.FirewallRule) error
 	ListFirewallRules(ctx context.Context, dbID, addonID string) ([]domain.FirewallRule, error)
 	DeleteFirewallRule(ctx context.Context, dbID, addonID, firewallRuleID string) error
+	ListFirewallManagedRanges(ctx context.Context, dbID, addonID string) ([]domain.FirewallManagedRange, error)
+
+	// Project.
+	CreateProject(ctx context.Context, project domain.Project) (domain.Project, error)
+	GetProject(ctx context.Context, projectID string) (domain.Project, error)
+	UpdateProject(ctx context.Context, project domain.Project) (domain.Project, error)
+	DeleteProject(ctx context.Context, projectID string) error
+	GetProjectPrivateNetwork(ctx context.Context, projectID string) (domain.ProjectPrivateNetwork, error)
 
 	// Application.
+	CreateApp(ctx context.Context, app domain.App) (domain.App, error)
+	GetApp(ctx context.Context, appID string) (domain.App, error)
+	DeleteApp(ctx context.Context, app domain.App) error
+	SetAppStack(ctx context.Context, appID, stackID string) error
+	SetAppProject(ctx context.Context, appID, projectID string) error
+	ListStacks(ctx context.Context) ([]domain.Stack, error)
 	FindApplicationVariable(ctx context.Context, appID, varName string) (string, error)
+	ListAppVariables(ctx context.Context, appID string) ([]domain.AppVariable, error)
+	SetAppVariables(ctx context.Context, appID string, variables []domain.AppVariable) error
+	UnsetAppVariable(ctx context.Context, appID, variableID string) error
+	ListAppContainers(ctx context.Context, appID string) ([]domain.AppContainer, error)
+	ScaleApp(ctx context.Context, appID string, containers []domain.AppContainer) error
 }
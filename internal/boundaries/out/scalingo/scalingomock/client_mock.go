@@ -37,6 +37,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CreateApp mocks base method.
+func (m *MockClient) CreateApp(ctx context.Context, app domain.App) (domain.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApp", ctx, app)
+	ret0, _ := ret[0].(domain.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApp indicates an expected call of CreateApp.
+func (mr *MockClientMockRecorder) CreateApp(ctx, app any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApp", reflect.TypeOf((*MockClient)(nil).CreateApp), ctx, app)
+}
+
 // CreateDatabase mocks base method.
 func (m *MockClient) CreateDatabase(ctx context.Context, db domain.Database) (domain.Database, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +96,35 @@ func (mr *MockClientMockRecorder) CreateFirewallRule(ctx, dbID, addonID, rule an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallRule", reflect.TypeOf((*MockClient)(nil).CreateFirewallRule), ctx, dbID, addonID, rule)
 }
 
+// CreateProject mocks base method.
+func (m *MockClient) CreateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, project)
+	ret0, _ := ret[0].(domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockClientMockRecorder) CreateProject(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockClient)(nil).CreateProject), ctx, project)
+}
+
+// DeleteApp mocks base method.
+func (m *MockClient) DeleteApp(ctx context.Context, app domain.App) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApp", ctx, app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApp indicates an expected call of DeleteApp.
+func (mr *MockClientMockRecorder) DeleteApp(ctx, app any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*MockClient)(nil).DeleteApp), ctx, app)
+}
+
 // DeleteDatabase mocks base method.
 func (m *MockClient) DeleteDatabase(ctx context.Context, dbID string) error {
 	m.ctrl.T.Helper()
@@ -123,6 +167,48 @@ func (mr *MockClientMockRecorder) DeleteFirewallRule(ctx, dbID, addonID, firewal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockClient)(nil).DeleteFirewallRule), ctx, dbID, addonID, firewallRuleID)
 }
 
+// DeleteProject mocks base method.
+func (m *MockClient) DeleteProject(ctx context.Context, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockClientMockRecorder) DeleteProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockClient)(nil).DeleteProject), ctx, projectID)
+}
+
+// DisableDatabaseFeature mocks base method.
+func (m *MockClient) DisableDatabaseFeature(ctx context.Context, appID, addonID, feature string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableDatabaseFeature", ctx, appID, addonID, feature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableDatabaseFeature indicates an expected call of DisableDatabaseFeature.
+func (mr *MockClientMockRecorder) DisableDatabaseFeature(ctx, appID, addonID, feature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableDatabaseFeature", reflect.TypeOf((*MockClient)(nil).DisableDatabaseFeature), ctx, appID, addonID, feature)
+}
+
+// EnableDatabaseFeature mocks base method.
+func (m *MockClient) EnableDatabaseFeature(ctx context.Context, appID, addonID, feature string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableDatabaseFeature", ctx, appID, addonID, feature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableDatabaseFeature indicates an expected call of EnableDatabaseFeature.
+func (mr *MockClientMockRecorder) EnableDatabaseFeature(ctx, appID, addonID, feature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableDatabaseFeature", reflect.TypeOf((*MockClient)(nil).EnableDatabaseFeature), ctx, appID, addonID, feature)
+}
+
 // FindApplicationVariable mocks base method.
 func (m *MockClient) FindApplicationVariable(ctx context.Context, appID, varName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +224,21 @@ func (mr *MockClientMockRecorder) FindApplicationVariable(ctx, appID, varName an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindApplicationVariable", reflect.TypeOf((*MockClient)(nil).FindApplicationVariable), ctx, appID, varName)
 }
 
+// GetApp mocks base method.
+func (m *MockClient) GetApp(ctx context.Context, appID string) (domain.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApp", ctx, appID)
+	ret0, _ := ret[0].(domain.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApp indicates an expected call of GetApp.
+func (mr *MockClientMockRecorder) GetApp(ctx, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApp", reflect.TypeOf((*MockClient)(nil).GetApp), ctx, appID)
+}
+
 // GetDatabase mocks base method.
 func (m *MockClient) GetDatabase(ctx context.Context, dbID string) (domain.Database, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +254,36 @@ func (mr *MockClientMockRecorder) GetDatabase(ctx, dbID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabase", reflect.TypeOf((*MockClient)(nil).GetDatabase), ctx, dbID)
 }
 
+// GetDatabaseCACertificate mocks base method.
+func (m *MockClient) GetDatabaseCACertificate(ctx context.Context, appID, addonID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseCACertificate", ctx, appID, addonID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseCACertificate indicates an expected call of GetDatabaseCACertificate.
+func (mr *MockClientMockRecorder) GetDatabaseCACertificate(ctx, appID, addonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseCACertificate", reflect.TypeOf((*MockClient)(nil).GetDatabaseCACertificate), ctx, appID, addonID)
+}
+
+// GetDatabaseDashboardsURL mocks base method.
+func (m *MockClient) GetDatabaseDashboardsURL(ctx context.Context, appID, addonID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseDashboardsURL", ctx, appID, addonID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseDashboardsURL indicates an expected call of GetDatabaseDashboardsURL.
+func (mr *MockClientMockRecorder) GetDatabaseDashboardsURL(ctx, appID, addonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseDashboardsURL", reflect.TypeOf((*MockClient)(nil).GetDatabaseDashboardsURL), ctx, appID, addonID)
+}
+
 // GetDatabaseNetworkConfiguration mocks base method.
 func (m *MockClient) GetDatabaseNetworkConfiguration(ctx context.Context, dbID string) (domain.DatabaseNetworkConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +299,66 @@ func (mr *MockClientMockRecorder) GetDatabaseNetworkConfiguration(ctx, dbID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseNetworkConfiguration", reflect.TypeOf((*MockClient)(nil).GetDatabaseNetworkConfiguration), ctx, dbID)
 }
 
+// GetProject mocks base method.
+func (m *MockClient) GetProject(ctx context.Context, projectID string) (domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", ctx, projectID)
+	ret0, _ := ret[0].(domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockClientMockRecorder) GetProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockClient)(nil).GetProject), ctx, projectID)
+}
+
+// GetProjectPrivateNetwork mocks base method.
+func (m *MockClient) GetProjectPrivateNetwork(ctx context.Context, projectID string) (domain.ProjectPrivateNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectPrivateNetwork", ctx, projectID)
+	ret0, _ := ret[0].(domain.ProjectPrivateNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectPrivateNetwork indicates an expected call of GetProjectPrivateNetwork.
+func (mr *MockClientMockRecorder) GetProjectPrivateNetwork(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectPrivateNetwork", reflect.TypeOf((*MockClient)(nil).GetProjectPrivateNetwork), ctx, projectID)
+}
+
+// ListAppContainers mocks base method.
+func (m *MockClient) ListAppContainers(ctx context.Context, appID string) ([]domain.AppContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppContainers", ctx, appID)
+	ret0, _ := ret[0].([]domain.AppContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppContainers indicates an expected call of ListAppContainers.
+func (mr *MockClientMockRecorder) ListAppContainers(ctx, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppContainers", reflect.TypeOf((*MockClient)(nil).ListAppContainers), ctx, appID)
+}
+
+// ListAppVariables mocks base method.
+func (m *MockClient) ListAppVariables(ctx context.Context, appID string) ([]domain.AppVariable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppVariables", ctx, appID)
+	ret0, _ := ret[0].([]domain.AppVariable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppVariables indicates an expected call of ListAppVariables.
+func (mr *MockClientMockRecorder) ListAppVariables(ctx, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppVariables", reflect.TypeOf((*MockClient)(nil).ListAppVariables), ctx, appID)
+}
+
 // ListDatabaseEndpoints mocks base method.
 func (m *MockClient) ListDatabaseEndpoints(ctx context.Context, dbID string) ([]domain.DatabaseEndpoint, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +389,21 @@ func (mr *MockClientMockRecorder) ListDatabaseNetPeerings(ctx, dbID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatabaseNetPeerings", reflect.TypeOf((*MockClient)(nil).ListDatabaseNetPeerings), ctx, dbID)
 }
 
+// ListFirewallManagedRanges mocks base method.
+func (m *MockClient) ListFirewallManagedRanges(ctx context.Context, dbID, addonID string) ([]domain.FirewallManagedRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirewallManagedRanges", ctx, dbID, addonID)
+	ret0, _ := ret[0].([]domain.FirewallManagedRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallManagedRanges indicates an expected call of ListFirewallManagedRanges.
+func (mr *MockClientMockRecorder) ListFirewallManagedRanges(ctx, dbID, addonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallManagedRanges", reflect.TypeOf((*MockClient)(nil).ListFirewallManagedRanges), ctx, dbID, addonID)
+}
+
 // ListFirewallRules mocks base method.
 func (m *MockClient) ListFirewallRules(ctx context.Context, dbID, addonID string) ([]domain.FirewallRule, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +419,105 @@ func (mr *MockClientMockRecorder) ListFirewallRules(ctx, dbID, addonID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRules", reflect.TypeOf((*MockClient)(nil).ListFirewallRules), ctx, dbID, addonID)
 }
 
+// ListStacks mocks base method.
+func (m *MockClient) ListStacks(ctx context.Context) ([]domain.Stack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStacks", ctx)
+	ret0, _ := ret[0].([]domain.Stack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStacks indicates an expected call of ListStacks.
+func (mr *MockClientMockRecorder) ListStacks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStacks", reflect.TypeOf((*MockClient)(nil).ListStacks), ctx)
+}
+
+// ScaleApp mocks base method.
+func (m *MockClient) ScaleApp(ctx context.Context, appID string, containers []domain.AppContainer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleApp", ctx, appID, containers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleApp indicates an expected call of ScaleApp.
+func (mr *MockClientMockRecorder) ScaleApp(ctx, appID, containers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleApp", reflect.TypeOf((*MockClient)(nil).ScaleApp), ctx, appID, containers)
+}
+
+// SetAppProject mocks base method.
+func (m *MockClient) SetAppProject(ctx context.Context, appID, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAppProject", ctx, appID, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAppProject indicates an expected call of SetAppProject.
+func (mr *MockClientMockRecorder) SetAppProject(ctx, appID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppProject", reflect.TypeOf((*MockClient)(nil).SetAppProject), ctx, appID, projectID)
+}
+
+// SetAppStack mocks base method.
+func (m *MockClient) SetAppStack(ctx context.Context, appID, stackID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAppStack", ctx, appID, stackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAppStack indicates an expected call of SetAppStack.
+func (mr *MockClientMockRecorder) SetAppStack(ctx, appID, stackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppStack", reflect.TypeOf((*MockClient)(nil).SetAppStack), ctx, appID, stackID)
+}
+
+// SetAppVariables mocks base method.
+func (m *MockClient) SetAppVariables(ctx context.Context, appID string, variables []domain.AppVariable) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAppVariables", ctx, appID, variables)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAppVariables indicates an expected call of SetAppVariables.
+func (mr *MockClientMockRecorder) SetAppVariables(ctx, appID, variables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppVariables", reflect.TypeOf((*MockClient)(nil).SetAppVariables), ctx, appID, variables)
+}
+
+// SetDatabaseProject mocks base method.
+func (m *MockClient) SetDatabaseProject(ctx context.Context, db domain.Database, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDatabaseProject", ctx, db, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDatabaseProject indicates an expected call of SetDatabaseProject.
+func (mr *MockClientMockRecorder) SetDatabaseProject(ctx, db, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDatabaseProject", reflect.TypeOf((*MockClient)(nil).SetDatabaseProject), ctx, db, projectID)
+}
+
+// UnsetAppVariable mocks base method.
+func (m *MockClient) UnsetAppVariable(ctx context.Context, appID, variableID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetAppVariable", ctx, appID, variableID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetAppVariable indicates an expected call of UnsetAppVariable.
+func (mr *MockClientMockRecorder) UnsetAppVariable(ctx, appID, variableID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetAppVariable", reflect.TypeOf((*MockClient)(nil).UnsetAppVariable), ctx, appID, variableID)
+}
+
 // UpdateDatabasePlan mocks base method.
 func (m *MockClient) UpdateDatabasePlan(ctx context.Context, db domain.Database, expectedPlan string) (domain.DatabaseStatus, error) {
 	m.ctrl.T.Helper()
@@ -227,3 +532,18 @@ func (mr *MockClientMockRecorder) UpdateDatabasePlan(ctx, db, expectedPlan any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabasePlan", reflect.TypeOf((*MockClient)(nil).UpdateDatabasePlan), ctx, db, expectedPlan)
 }
+
+// UpdateProject mocks base method.
+func (m *MockClient) UpdateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", ctx, project)
+	ret0, _ := ret[0].(domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockClientMockRecorder) UpdateProject(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockClient)(nil).UpdateProject), ctx, project)
+}
@@ -0,0 +1,154 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	errors "github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/vault"
+)
+
+const (
+	tokenHeader = "X-Vault-Token"
+
+	requestTimeout = 30 * time.Second
+)
+
+type client struct {
+	address    string
+	token      string
+	httpClient *http.Client
+}
+
+func NewClient(ctx context.Context, address, token string) (vault.Client, error) {
+	if address == "" {
+		return nil, errors.New(ctx, "empty vault address")
+	}
+	if token == "" {
+		return nil, errors.New(ctx, "empty vault token")
+	}
+
+	parsedAddress, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "parse vault address")
+	}
+	if parsedAddress.Scheme != "http" && parsedAddress.Scheme != "https" {
+		return nil, errors.Newf(ctx, "invalid vault address scheme %q", parsedAddress.Scheme)
+	}
+
+	return &client{
+		address:    strings.TrimSuffix(address, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+type kvSecretResponse struct {
+	Data struct {
+		Data     map[string]string `json:"data"`
+		Metadata struct {
+			Version int `json:"version"`
+		} `json:"metadata"`
+	} `json:"data"`
+}
+
+type kvSecretRequest struct {
+	Options kvSecretRequestOptions `json:"options"`
+	Data    map[string]string      `json:"data"`
+}
+
+type kvSecretRequestOptions struct {
+	CAS int `json:"cas"`
+}
+
+func (c *client) ReadKVSecret(ctx context.Context, mount, path string) (map[string]string, int, error) {
+	res, err := c.do(ctx, http.MethodGet, c.kvDataURL(mount, path), nil)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, "read kv secret")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return map[string]string{}, 0, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, 0, errors.Newf(ctx, "read kv secret: unexpected status %d", res.StatusCode)
+	}
+
+	var secret kvSecretResponse
+	err = json.NewDecoder(res.Body).Decode(&secret)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, "decode kv secret")
+	}
+
+	data := secret.Data.Data
+	if data == nil {
+		data = map[string]string{}
+	}
+	return data, secret.Data.Metadata.Version, nil
+}
+
+func (c *client) WriteKVSecret(ctx context.Context, mount, path string, data map[string]string, cas int) error {
+	body, err := json.Marshal(kvSecretRequest{
+		Options: kvSecretRequestOptions{CAS: cas},
+		Data:    data,
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, "encode kv secret")
+	}
+
+	res, err := c.do(ctx, http.MethodPost, c.kvDataURL(mount, path), body)
+	if err != nil {
+		return errors.Wrap(ctx, err, "write kv secret")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
+		return errors.Newf(ctx, "write kv secret: unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (c *client) DeleteKVSecret(ctx context.Context, mount, path string) error {
+	res, err := c.do(ctx, http.MethodDelete, c.kvMetadataURL(mount, path), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, "delete kv secret")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
+		return errors.Newf(ctx, "delete kv secret: unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (c *client) kvDataURL(mount, path string) string {
+	return fmt.Sprintf("%s/v1/%s/data/%s", c.address, strings.Trim(mount, "/"), strings.Trim(path, "/"))
+}
+
+func (c *client) kvMetadataURL(mount, path string) string {
+	return fmt.Sprintf("%s/v1/%s/metadata/%s", c.address, strings.Trim(mount, "/"), strings.Trim(path, "/"))
+}
+
+func (c *client) do(ctx context.Context, method, reqURL string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "new request")
+	}
+	req.Header.Set(tokenHeader, c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "%s %s", method, reqURL)
+	}
+	return res, nil
+}
@@ -0,0 +1,165 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Run("it fails because of empty address", func(t *testing.T) {
+		vaultClient, err := NewClient(t.Context(), "", "token")
+		require.EqualError(t, err, "empty vault address")
+		require.Nil(t, vaultClient)
+	})
+
+	t.Run("it fails because of empty token", func(t *testing.T) {
+		vaultClient, err := NewClient(t.Context(), "http://127.0.0.1:8200", "")
+		require.EqualError(t, err, "empty vault token")
+		require.Nil(t, vaultClient)
+	})
+
+	t.Run("it fails because of invalid address scheme", func(t *testing.T) {
+		vaultClient, err := NewClient(t.Context(), "vault.example.test:8200", "token")
+		require.ErrorContains(t, err, "invalid vault address scheme")
+		require.Nil(t, vaultClient)
+	})
+}
+
+func TestClient_ReadKVSecret(t *testing.T) {
+	t.Run("it reads the latest secret version", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/secret/data/team/my-db", r.URL.Path)
+			assert.Equal(t, "s3cr3t", r.Header.Get(tokenHeader))
+
+			_, writeErr := w.Write([]byte(`{"data":{"data":{"PG_URL":"postgres://host/db"},"metadata":{"version":3}}}`))
+			assert.NoError(t, writeErr)
+		}))
+		defer server.Close()
+
+		vaultClient, err := NewClient(t.Context(), server.URL+"/", "s3cr3t")
+		require.NoError(t, err)
+
+		data, version, err := vaultClient.ReadKVSecret(t.Context(), "secret", "/team/my-db")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"PG_URL": "postgres://host/db"}, data)
+		require.Equal(t, 3, version)
+	})
+
+	t.Run("it returns empty data when the secret does not exist", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		vaultClient, err := NewClient(t.Context(), server.URL, "s3cr3t")
+		require.NoError(t, err)
+
+		data, version, err := vaultClient.ReadKVSecret(t.Context(), "secret", "my-db")
+		require.NoError(t, err)
+		require.Empty(t, data)
+		require.Zero(t, version)
+	})
+
+	t.Run("it fails on unexpected status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		vaultClient, err := NewClient(t.Context(), server.URL, "s3cr3t")
+		require.NoError(t, err)
+
+		_, _, err = vaultClient.ReadKVSecret(t.Context(), "secret", "my-db")
+		require.ErrorContains(t, err, "unexpected status 403")
+	})
+}
+
+func TestClient_WriteKVSecret(t *testing.T) {
+	t.Run("it writes the secret with check-and-set", func(t *testing.T) {
+		var payload map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/v1/kv/data/my-db", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			decodeErr := json.NewDecoder(r.Body).Decode(&payload)
+			assert.NoError(t, decodeErr)
+
+			_, writeErr := w.Write([]byte(`{"data":{"version":4}}`))
+			assert.NoError(t, writeErr)
+		}))
+		defer server.Close()
+
+		vaultClient, err := NewClient(t.Context(), server.URL, "s3cr3t")
+		require.NoError(t, err)
+
+		err = vaultClient.WriteKVSecret(t.Context(), "kv", "my-db", map[string]string{"PG_URL": "postgres://host/db"}, 3)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"cas": float64(3)}, payload["options"])
+		assert.Equal(t, map[string]any{"PG_URL": "postgres://host/db"}, payload["data"])
+	})
+
+	t.Run("it fails on check-and-set mismatch", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		vaultClient, err := NewClient(t.Context(), server.URL, "s3cr3t")
+		require.NoError(t, err)
+
+		err = vaultClient.WriteKVSecret(t.Context(), "kv", "my-db", map[string]string{"PG_URL": "postgres://host/db"}, 1)
+		require.ErrorContains(t, err, "unexpected status 400")
+	})
+}
+
+func TestClient_DeleteKVSecret(t *testing.T) {
+	t.Run("it deletes the secret metadata and all its versions", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, "/v1/kv/metadata/team/my-db", r.URL.Path)
+			assert.Equal(t, "s3cr3t", r.Header.Get(tokenHeader))
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		vaultClient, err := NewClient(t.Context(), server.URL, "s3cr3t")
+		require.NoError(t, err)
+
+		err = vaultClient.DeleteKVSecret(t.Context(), "kv", "team/my-db")
+		require.NoError(t, err)
+	})
+
+	t.Run("it succeeds when the secret does not exist", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		vaultClient, err := NewClient(t.Context(), server.URL, "s3cr3t")
+		require.NoError(t, err)
+
+		err = vaultClient.DeleteKVSecret(t.Context(), "kv", "my-db")
+		require.NoError(t, err)
+	})
+
+	t.Run("it fails on unexpected status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		vaultClient, err := NewClient(t.Context(), server.URL, "s3cr3t")
+		require.NoError(t, err)
+
+		err = vaultClient.DeleteKVSecret(t.Context(), "kv", "my-db")
+		require.ErrorContains(t, err, "unexpected status 403")
+	})
+}
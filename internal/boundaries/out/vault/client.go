@@ -0,0 +1,16 @@
+package vault
+
+import "context"
+
+// Wrapper for HashiCorp Vault KV v2 secrets engine HTTP API.
+type Client interface {
+	// ReadKVSecret returns the latest version data of a secret and its version number.
+	// A missing secret returns empty data and version 0.
+	ReadKVSecret(ctx context.Context, mount, path string) (map[string]string, int, error)
+	// WriteKVSecret writes a new version of a secret.
+	// The write only succeeds if the current version of the secret matches `cas` (check-and-set).
+	WriteKVSecret(ctx context.Context, mount, path string, data map[string]string, cas int) error
+	// DeleteKVSecret permanently deletes all the versions and the metadata of a secret.
+	// Deleting a missing secret succeeds.
+	DeleteKVSecret(ctx context.Context, mount, path string) error
+}
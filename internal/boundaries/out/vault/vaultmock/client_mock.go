@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/Scalingo/scalingo-operator/internal/boundaries/out/vault (interfaces: Client)
+
+// Package vaultmock is a generated GoMock package.
+package vaultmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+	isgomock struct{}
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteKVSecret mocks base method.
+func (m *MockClient) DeleteKVSecret(ctx context.Context, mount, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKVSecret", ctx, mount, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKVSecret indicates an expected call of DeleteKVSecret.
+func (mr *MockClientMockRecorder) DeleteKVSecret(ctx, mount, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKVSecret", reflect.TypeOf((*MockClient)(nil).DeleteKVSecret), ctx, mount, path)
+}
+
+// ReadKVSecret mocks base method.
+func (m *MockClient) ReadKVSecret(ctx context.Context, mount, path string) (map[string]string, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadKVSecret", ctx, mount, path)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadKVSecret indicates an expected call of ReadKVSecret.
+func (mr *MockClientMockRecorder) ReadKVSecret(ctx, mount, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadKVSecret", reflect.TypeOf((*MockClient)(nil).ReadKVSecret), ctx, mount, path)
+}
+
+// WriteKVSecret mocks base method.
+func (m *MockClient) WriteKVSecret(ctx context.Context, mount, path string, data map[string]string, cas int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteKVSecret", ctx, mount, path, data, cas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteKVSecret indicates an expected call of WriteKVSecret.
+func (mr *MockClientMockRecorder) WriteKVSecret(ctx, mount, path, data, cas any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteKVSecret", reflect.TypeOf((*MockClient)(nil).WriteKVSecret), ctx, mount, path, data, cas)
+}
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// toDatabase converts the desired state shared by the database engines to internal type.
+func toDatabase(ctx context.Context, dbType domain.DatabaseType, resourceName string, spec apiv1.DatabaseSpec) (domain.Database, error) {
+	rules, err := toFirewallRules(ctx, spec.Networking)
+	if err != nil {
+		return domain.Database{}, errors.Wrap(ctx, err, "to firewall rules")
+	}
+
+	ipRange := spec.Networking.IPRange
+	if ipRange != "" {
+		ipRange, err = domain.NormalizeCIDR(ipRange)
+		if err != nil {
+			return domain.Database{}, errors.Wrap(ctx, err, "invalid ip range")
+		}
+	}
+
+	if spec.AppID != "" {
+		err = validateAppAddonSpec(ctx, spec)
+		if err != nil {
+			return domain.Database{}, errors.Wrap(ctx, err, "invalid app addon")
+		}
+	}
+
+	dbName := spec.Name
+	if dbName == "" {
+		dbName = resourceName
+	}
+
+	return domain.Database{
+		Name:          dbName,
+		AppID:         spec.AppID,
+		Type:          dbType,
+		Plan:          spec.Plan,
+		ProjectID:     spec.ProjectID,
+		IPRange:       ipRange,
+		FireWallRules: rules,
+
+		IsFirewallExclusive: spec.Networking.Firewall != nil && spec.Networking.Firewall.Exclusive,
+	}, nil
+}
+
+// validateAppAddonSpec rejects the settings specific to the dedicated resources databases, which the addons of
+// an existing application do not support.
+func validateAppAddonSpec(ctx context.Context, spec apiv1.DatabaseSpec) error {
+	switch {
+	case spec.ProjectID != "" || spec.ProjectRef != nil:
+		return errors.New(ctx, "project is set by the application")
+	case spec.Networking.IPRange != "":
+		return errors.New(ctx, "ip_range is not supported")
+	case spec.Networking.IsOutscaleNetPeeringEnabled():
+		return errors.New(ctx, "outscale net peering is not supported")
+	case spec.Networking.EgressNetworkPolicy != nil:
+		return errors.New(ctx, "egress_network_policy is not supported")
+	}
+	return nil
+}
@@ -13,8 +13,13 @@ func toFirewallRules(ctx context.Context, networkSpec apiv1.NetworkingSpec) ([]d
 		return nil, nil
 	}
 
-	newRules := make([]domain.FirewallRule, 0, len(networkSpec.Firewall.Rules))
-	for _, rule := range networkSpec.Firewall.Rules {
+	return ToFirewallRules(ctx, networkSpec.Firewall.Rules)
+}
+
+// ToFirewallRules converts firewall rules from Kubebuilder type to internal type.
+func ToFirewallRules(ctx context.Context, rules []apiv1.FirewallRuleSpec) ([]domain.FirewallRule, error) {
+	newRules := make([]domain.FirewallRule, 0, len(rules))
+	for _, rule := range rules {
 		newRule, err := toFirewallRule(ctx, rule)
 		if err != nil {
 			return nil, err
@@ -30,11 +35,34 @@ func toFirewallRule(ctx context.Context, rule apiv1.FirewallRuleSpec) (domain.Fi
 		CIDR:    rule.CIDR,
 		Label:   rule.Label,
 		RangeID: rule.RangeID,
+
+		RangeName: rule.RangeName,
 	}
 
 	err := newRule.Validate()
 	if err != nil {
 		return domain.FirewallRule{}, errors.Wrap(ctx, err, "validate firewall rule")
 	}
+
+	newRule, err = newRule.Normalize()
+	if err != nil {
+		return domain.FirewallRule{}, errors.Wrap(ctx, err, "normalize firewall rule")
+	}
 	return newRule, nil
 }
+
+// ToFirewallManagedRangesStatus converts the resolved managed ranges from internal type to Kubebuilder type.
+func ToFirewallManagedRangesStatus(ranges []domain.FirewallManagedRange) []apiv1.FirewallManagedRangeStatus {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	status := make([]apiv1.FirewallManagedRangeStatus, 0, len(ranges))
+	for _, managedRange := range ranges {
+		status = append(status, apiv1.FirewallManagedRangeStatus{
+			Name: managedRange.Name,
+			ID:   managedRange.ID,
+		})
+	}
+	return status
+}
@@ -222,6 +222,32 @@ func TestToFirewallRule(t *testing.T) {
 		require.Empty(t, rule.CIDR)
 	})
 
+	t.Run("it normalizes the cidr of custom_range rule", func(t *testing.T) {
+		for cidr, expected := range map[string]string{
+			"10.0.0.1/8":              "10.0.0.0/8",
+			"2001:db8:0:0:0:0:0:1/64": "2001:db8::/64",
+		} {
+			rule, err := toFirewallRule(t.Context(), apiv1.FirewallRuleSpec{Type: "custom_range", CIDR: cidr})
+
+			require.NoError(t, err)
+			require.Equal(t, expected, rule.CIDR)
+		}
+	})
+
+	t.Run("it converts managed_range rule with range name", func(t *testing.T) {
+		spec := apiv1.FirewallRuleSpec{
+			Type:      "managed_range",
+			RangeName: "Scalingo osc-fr1 apps",
+		}
+
+		rule, err := toFirewallRule(t.Context(), spec)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.FirewallRuleTypeManagedRange, rule.Type)
+		require.Equal(t, "Scalingo osc-fr1 apps", rule.RangeName)
+		require.Empty(t, rule.RangeID)
+	})
+
 	t.Run("it returns error for invalid type", func(t *testing.T) {
 		spec := apiv1.FirewallRuleSpec{
 			Type: "invalid_type",
@@ -272,3 +298,9 @@ func TestToFirewallRule(t *testing.T) {
 		require.Equal(t, domain.FirewallRule{}, rule)
 	})
 }
+
+func TestToFirewallManagedRangesStatus(t *testing.T) {
+	require.Nil(t, ToFirewallManagedRangesStatus(nil))
+	require.Equal(t, []apiv1.FirewallManagedRangeStatus{{Name: "Scalingo osc-fr1 apps", ID: "man-osc-fr1-egress"}},
+		ToFirewallManagedRangesStatus([]domain.FirewallManagedRange{{ID: "man-osc-fr1-egress", Name: "Scalingo osc-fr1 apps"}}))
+}
@@ -0,0 +1,13 @@
+package adapters
+
+import (
+	"context"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// Convert from Kubebuilder type to internal type.
+func MongoDBToDatabase(ctx context.Context, mongodb apiv1.MongoDB) (domain.Database, error) {
+	return toDatabase(ctx, domain.DatabaseTypeMongoDB, mongodb.Name, mongodb.Spec.DatabaseSpec)
+}
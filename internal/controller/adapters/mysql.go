@@ -0,0 +1,13 @@
+package adapters
+
+import (
+	"context"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// Convert from Kubebuilder type to internal type.
+func MySQLToDatabase(ctx context.Context, mysql apiv1.MySQL) (domain.Database, error) {
+	return toDatabase(ctx, domain.DatabaseTypeMySQL, mysql.Name, mysql.Spec.DatabaseSpec)
+}
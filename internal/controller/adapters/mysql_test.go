@@ -0,0 +1,60 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestMySQLToDatabase(t *testing.T) {
+	const (
+		resourceName = "my-resource-name"
+		dbName       = "my-db-name"
+		dbPlan       = "mysql-dr-starter-1024"
+	)
+
+	t.Run("it converts mysql data from Kubebuilder to internal format", func(t *testing.T) {
+		mysql := apiv1.MySQL{
+			Spec: apiv1.MySQLSpec{
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Name: dbName,
+					Networking: apiv1.NetworkingSpec{
+						IPRange: "10.231.23.0/24",
+					},
+					Plan: dbPlan,
+				},
+			},
+		}
+		expected := domain.Database{
+			Name:    dbName,
+			Type:    domain.DatabaseTypeMySQL,
+			Plan:    dbPlan,
+			IPRange: "10.231.23.0/24",
+		}
+		res, err := MySQLToDatabase(t.Context(), mysql)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("it fallsback on resource name for missing database name", func(t *testing.T) {
+		mysql := apiv1.MySQL{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+			},
+			Spec: apiv1.MySQLSpec{
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Plan: dbPlan,
+				},
+			},
+		}
+		res, err := MySQLToDatabase(t.Context(), mysql)
+
+		require.NoError(t, err)
+		require.Equal(t, resourceName, res.Name)
+	})
+}
@@ -0,0 +1,13 @@
+package adapters
+
+import (
+	"context"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// Convert from Kubebuilder type to internal type.
+func OpenSearchToDatabase(ctx context.Context, opensearch apiv1.OpenSearch) (domain.Database, error) {
+	return toDatabase(ctx, domain.DatabaseTypeOpenSearch, opensearch.Name, opensearch.Spec.DatabaseSpec)
+}
@@ -21,12 +21,14 @@ func TestPostgreSQLToDatabase(t *testing.T) {
 	t.Run("it converts postgresql data from Kubebuilder to internal format", func(t *testing.T) {
 		pg := apiv1.PostgreSQL{
 			Spec: apiv1.PostgreSQLSpec{
-				Name: dbName,
-				Networking: apiv1.NetworkingSpec{
-					IPRange: "10.231.23.0/24",
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Name: dbName,
+					Networking: apiv1.NetworkingSpec{
+						IPRange: "10.231.23.0/24",
+					},
+					Plan:      dbPlan,
+					ProjectID: projectID,
 				},
-				Plan:      dbPlan,
-				ProjectID: projectID,
 			},
 		}
 		expected := domain.Database{
@@ -48,8 +50,10 @@ func TestPostgreSQLToDatabase(t *testing.T) {
 				Name: resourceName,
 			},
 			Spec: apiv1.PostgreSQLSpec{
-				Plan:      dbPlan,
-				ProjectID: projectID,
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Plan:      dbPlan,
+					ProjectID: projectID,
+				},
 			},
 		}
 		res, err := PostgreSQLToDatabase(t.Context(), pg)
@@ -57,4 +61,110 @@ func TestPostgreSQLToDatabase(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, resourceName, res.Name)
 	})
+
+	t.Run("it converts the exclusive firewall", func(t *testing.T) {
+		pg := apiv1.PostgreSQL{
+			Spec: apiv1.PostgreSQLSpec{
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Name: dbName,
+					Networking: apiv1.NetworkingSpec{
+						Firewall: &apiv1.FirewallSpec{
+							Rules:     []apiv1.FirewallRuleSpec{{Type: "custom_range", CIDR: "10.0.0.0/16"}},
+							Exclusive: true,
+						},
+					},
+					Plan: dbPlan,
+				},
+			},
+		}
+		res, err := PostgreSQLToDatabase(t.Context(), pg)
+
+		require.NoError(t, err)
+		require.True(t, res.IsFirewallExclusive)
+	})
+
+	t.Run("it normalizes the ip range", func(t *testing.T) {
+		pg := apiv1.PostgreSQL{
+			Spec: apiv1.PostgreSQLSpec{
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Name:       dbName,
+					Networking: apiv1.NetworkingSpec{IPRange: "10.231.23.12/24"},
+					Plan:       dbPlan,
+				},
+			},
+		}
+		res, err := PostgreSQLToDatabase(t.Context(), pg)
+
+		require.NoError(t, err)
+		require.Equal(t, "10.231.23.0/24", res.IPRange)
+	})
+
+	t.Run("it fails with an invalid ip range", func(t *testing.T) {
+		pg := apiv1.PostgreSQL{
+			Spec: apiv1.PostgreSQLSpec{
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Name:       dbName,
+					Networking: apiv1.NetworkingSpec{IPRange: "10.231.23.0"},
+					Plan:       dbPlan,
+				},
+			},
+		}
+		_, err := PostgreSQLToDatabase(t.Context(), pg)
+
+		require.ErrorContains(t, err, "invalid ip range")
+	})
+
+	t.Run("it converts the app of an addon", func(t *testing.T) {
+		pg := apiv1.PostgreSQL{
+			Spec: apiv1.PostgreSQLSpec{
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Name:  dbName,
+					Plan:  "postgresql-starter-512",
+					AppID: "my-app",
+				},
+			},
+		}
+		expected := domain.Database{
+			Name:  dbName,
+			AppID: "my-app",
+			Type:  domain.DatabaseTypePostgreSQL,
+			Plan:  "postgresql-starter-512",
+		}
+		res, err := PostgreSQLToDatabase(t.Context(), pg)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("it fails with settings of dedicated resources databases on an addon", func(t *testing.T) {
+		pg := apiv1.PostgreSQL{
+			Spec: apiv1.PostgreSQLSpec{
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Name:       dbName,
+					Networking: apiv1.NetworkingSpec{IPRange: "10.231.23.0/24"},
+					Plan:       "postgresql-starter-512",
+					AppID:      "my-app",
+				},
+			},
+		}
+		_, err := PostgreSQLToDatabase(t.Context(), pg)
+
+		require.ErrorContains(t, err, "invalid app addon: ip_range is not supported")
+	})
+
+	t.Run("it fails with a project reference on an addon", func(t *testing.T) {
+		pg := apiv1.PostgreSQL{
+			Spec: apiv1.PostgreSQLSpec{
+				DatabaseSpec: apiv1.DatabaseSpec{
+					Name:       dbName,
+					Plan:       "postgresql-starter-512",
+					AppID:      "my-app",
+					ProjectRef: &apiv1.ProjectReference{Name: "my-project"},
+				},
+			},
+		}
+		_, err := PostgreSQLToDatabase(t.Context(), pg)
+
+		require.ErrorContains(t, err, "invalid app addon: project is set by the application")
+	})
 }
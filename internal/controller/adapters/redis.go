@@ -0,0 +1,36 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+const (
+	// redisAOFFeature enables the append only file persistence, instead of the RDB snapshots.
+	redisAOFFeature = "redis-aof"
+	// redisEvictionPolicyFeaturePrefix prefixes the eviction policy of the feature selecting it.
+	redisEvictionPolicyFeaturePrefix = "redis-eviction-policy-"
+)
+
+// Convert from Kubebuilder type to internal type.
+func RedisToDatabase(ctx context.Context, redis apiv1.Redis) (domain.Database, error) {
+	db, err := toDatabase(ctx, domain.DatabaseTypeRedis, redis.Name, redis.Spec.DatabaseSpec)
+	if err != nil {
+		return domain.Database{}, errors.Wrap(ctx, err, "to database")
+	}
+
+	db.Features = map[string]bool{
+		redisAOFFeature: redis.Spec.Persistence == apiv1.RedisPersistenceAOF,
+	}
+
+	// Only one eviction policy feature is enabled at once.
+	if redis.Spec.EvictionPolicy != "" {
+		for _, evictionPolicy := range apiv1.RedisEvictionPolicies {
+			db.Features[redisEvictionPolicyFeaturePrefix+string(evictionPolicy)] = evictionPolicy == redis.Spec.EvictionPolicy
+		}
+	}
+	return db, nil
+}
@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestRedisToDatabase(t *testing.T) {
+	const (
+		dbName = "my-db-name"
+		dbPlan = "redis-dr-starter-512"
+	)
+
+	tests := []struct {
+		name             string
+		persistence      apiv1.RedisPersistence
+		evictionPolicy   apiv1.RedisEvictionPolicy
+		expectedFeatures map[string]bool
+	}{
+		{
+			name:             "it disables the append only file with the RDB persistence",
+			persistence:      apiv1.RedisPersistenceRDB,
+			expectedFeatures: map[string]bool{"redis-aof": false},
+		},
+		{
+			name:             "it enables the append only file with the AOF persistence",
+			persistence:      apiv1.RedisPersistenceAOF,
+			expectedFeatures: map[string]bool{"redis-aof": true},
+		},
+		{
+			name:           "it enables only the eviction policy feature selected",
+			persistence:    apiv1.RedisPersistenceRDB,
+			evictionPolicy: apiv1.RedisEvictionPolicyAllKeysLRU,
+			expectedFeatures: map[string]bool{
+				"redis-aof":                             false,
+				"redis-eviction-policy-noeviction":      false,
+				"redis-eviction-policy-allkeys-lru":     true,
+				"redis-eviction-policy-allkeys-lfu":     false,
+				"redis-eviction-policy-allkeys-random":  false,
+				"redis-eviction-policy-volatile-lru":    false,
+				"redis-eviction-policy-volatile-lfu":    false,
+				"redis-eviction-policy-volatile-random": false,
+				"redis-eviction-policy-volatile-ttl":    false,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redis := apiv1.Redis{
+				Spec: apiv1.RedisSpec{
+					DatabaseSpec: apiv1.DatabaseSpec{
+						Name: dbName,
+						Plan: dbPlan,
+					},
+					Persistence:    test.persistence,
+					EvictionPolicy: test.evictionPolicy,
+				},
+			}
+			expected := domain.Database{
+				Name:     dbName,
+				Type:     domain.DatabaseTypeRedis,
+				Plan:     dbPlan,
+				Features: test.expectedFeatures,
+			}
+
+			res, err := RedisToDatabase(t.Context(), redis)
+
+			require.NoError(t, err)
+			require.Equal(t, expected, res)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package adapters
+
+import (
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// Convert from Kubebuilder type to internal type. The variables are resolved from the env of the spec.
+func ScalingoAppToApp(app apiv1.ScalingoApp, variables []domain.AppVariable) domain.App {
+	name := app.Spec.Name
+	if name == "" {
+		name = app.Name
+	}
+
+	var containers []domain.AppContainer
+	for _, container := range app.Spec.Containers {
+		containers = append(containers, domain.AppContainer{
+			Name:   container.Name,
+			Amount: container.Amount,
+			Size:   container.Size,
+		})
+	}
+
+	return domain.App{
+		ID:         app.Status.ScalingoAppID,
+		Name:       name,
+		ProjectID:  app.Spec.ProjectID,
+		Stack:      app.Spec.Stack,
+		Variables:  variables,
+		Containers: containers,
+	}
+}
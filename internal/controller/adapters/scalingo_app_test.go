@@ -0,0 +1,48 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestScalingoAppToApp(t *testing.T) {
+	t.Run("it converts the app resource", func(t *testing.T) {
+		app := apiv1.ScalingoApp{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-resource-name"},
+			Spec: apiv1.ScalingoAppSpec{
+				Name:       "my-app",
+				ProjectID:  "prj-123",
+				Stack:      "scalingo-22",
+				Containers: []apiv1.AppContainerSpec{{Name: "web", Amount: 2, Size: "M"}},
+			},
+			Status: apiv1.ScalingoAppStatus{ScalingoAppID: "app-123"},
+		}
+		variables := []domain.AppVariable{{Name: "LOG_LEVEL", Value: "debug"}}
+
+		res := ScalingoAppToApp(app, variables)
+
+		require.Equal(t, domain.App{
+			ID:         "app-123",
+			Name:       "my-app",
+			ProjectID:  "prj-123",
+			Stack:      "scalingo-22",
+			Variables:  variables,
+			Containers: []domain.AppContainer{{Name: "web", Amount: 2, Size: "M"}},
+		}, res)
+	})
+
+	t.Run("it fallbacks on the resource name", func(t *testing.T) {
+		app := apiv1.ScalingoApp{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-resource-name"},
+		}
+
+		res := ScalingoAppToApp(app, nil)
+
+		require.Equal(t, domain.App{Name: "my-resource-name"}, res)
+	})
+}
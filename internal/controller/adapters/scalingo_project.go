@@ -0,0 +1,33 @@
+package adapters
+
+import (
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// Convert from Kubebuilder type to internal type.
+func ScalingoProjectToProject(project apiv1.ScalingoProject) domain.Project {
+	name := project.Spec.Name
+	if name == "" {
+		name = project.Name
+	}
+
+	return domain.Project{
+		ID:      project.Status.ScalingoProjectID,
+		Name:    name,
+		Default: project.Spec.Default,
+	}
+}
+
+func ToProjectPrivateNetworkStatus(privateNetwork *domain.ProjectPrivateNetwork) *apiv1.ProjectPrivateNetworkStatus {
+	if privateNetwork == nil {
+		return nil
+	}
+
+	return &apiv1.ProjectPrivateNetworkStatus{
+		ID:          privateNetwork.ID,
+		Subnet:      privateNetwork.Subnet,
+		Gateway:     privateNetwork.Gateway,
+		MaxIPsCount: privateNetwork.MaxIPsCount,
+	}
+}
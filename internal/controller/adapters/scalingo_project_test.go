@@ -0,0 +1,57 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestScalingoProjectToProject(t *testing.T) {
+	t.Run("it converts the project resource", func(t *testing.T) {
+		project := apiv1.ScalingoProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-resource-name"},
+			Spec:       apiv1.ScalingoProjectSpec{Name: "my-project", Default: true},
+			Status:     apiv1.ScalingoProjectStatus{ScalingoProjectID: "prj-123"},
+		}
+
+		res := ScalingoProjectToProject(project)
+
+		require.Equal(t, domain.Project{ID: "prj-123", Name: "my-project", Default: true}, res)
+	})
+
+	t.Run("it fallbacks on the resource name", func(t *testing.T) {
+		project := apiv1.ScalingoProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-resource-name"},
+		}
+
+		res := ScalingoProjectToProject(project)
+
+		require.Equal(t, "my-resource-name", res.Name)
+	})
+}
+
+func TestToProjectPrivateNetworkStatus(t *testing.T) {
+	t.Run("it returns nil without private network", func(t *testing.T) {
+		require.Nil(t, ToProjectPrivateNetworkStatus(nil))
+	})
+
+	t.Run("it converts the private network", func(t *testing.T) {
+		status := ToProjectPrivateNetworkStatus(&domain.ProjectPrivateNetwork{
+			ID:          "pn-123",
+			Subnet:      "10.240.0.0/24",
+			Gateway:     "10.240.0.1",
+			MaxIPsCount: 253,
+		})
+
+		require.Equal(t, &apiv1.ProjectPrivateNetworkStatus{
+			ID:          "pn-123",
+			Subnet:      "10.240.0.0/24",
+			Gateway:     "10.240.0.1",
+			MaxIPsCount: 253,
+		}, status)
+	})
+}
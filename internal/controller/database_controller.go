@@ -0,0 +1,934 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/controller/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/controller/networking"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	databaseusecases "github.com/Scalingo/scalingo-operator/internal/usecases/database"
+	databasebase "github.com/Scalingo/scalingo-operator/internal/usecases/database/base"
+)
+
+// DatabaseReconciler reconciles the database custom resources of an engine. The engine provides the engine
+// specific parts: the custom resource types, the database type, the finalizer and the connection information
+// secrets.
+type DatabaseReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	Engine DatabaseEngine
+
+	// NewDatabaseManager creates the manager of the Scalingo databases, databasebase.NewManager by default.
+	NewDatabaseManager func(ctx context.Context, dbType domain.DatabaseType, apiToken, region, appID string) (databaseusecases.Manager, error)
+}
+
+// Reconcile moves the current state of the database resource closer to its desired state, whatever its
+// engine.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.1/pkg/reconcile
+func (r *DatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	// Fetch the instance.
+	object := r.Engine.NewObject()
+	err := r.Get(ctx, req.NamespacedName, object)
+	if err != nil {
+		// Handle error, if it's not found, there's nothing to do.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	spec := object.GetDatabaseSpec()
+	status := object.GetDatabaseStatus()
+
+	// State information.
+	containsFinalizer := controllerutil.ContainsFinalizer(object, r.Engine.FinalizerName())
+	hasRunningAnnotation := helpers.HasDatabaseRunningAnnotation(object)
+	isDatabaseStatusInitialized := helpers.IsDatabaseInitialized(status.Conditions)
+
+	isDatabaseProvisioning := helpers.IsDatabaseProvisioning(status.Conditions)
+	isDatabaseAvailable := helpers.IsDatabaseAvailable(status.Conditions)
+	isDatabaseRunning := helpers.IsDatabaseRunning(object)
+	isDatabaseDeletionRequested := helpers.IsDatabaseDeletionRequested(object)
+
+	// Trigger variables.
+	var (
+		triggerUpdate       bool
+		triggerStatusUpdate bool
+		triggerRequeueLater time.Duration
+
+		isConnInfoWritten bool
+
+		// currentDB is the database observed once provisioned.
+		currentDB domain.Database
+		// currentConnInfo is the connection information of currentDB, composed once when first needed.
+		currentConnInfo *connInfo
+	)
+
+	// Initialization and resource updates.
+	// (no Scalingo client interaction)
+	//
+	// Initialize steps:
+	// 1/ add finalizer + requeue
+	// 2/ set initial Status.Condition + requeue
+	// 3/ set initial Annotations + requeue
+	//
+	// Note: the `requeue` prevents resource update conflicts.
+	switch {
+	case !containsFinalizer:
+		log.Info("Add finalizer to resource", "finalizer", r.Engine.FinalizerName())
+
+		controllerutil.AddFinalizer(object, r.Engine.FinalizerName())
+		err := r.Update(ctx, object)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "add resource finalizer")
+		}
+		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
+
+	case !isDatabaseStatusInitialized:
+		log.Info("Initialize resource status conditions")
+
+		helpers.SetDatabaseInitialStatus(&status.Conditions)
+		triggerStatusUpdate = true
+
+	case isDatabaseStatusInitialized && !hasRunningAnnotation:
+		log.Info("Initialize resource annotations")
+
+		helpers.SetDatabaseIsNotRunning(object)
+		triggerUpdate = true
+
+	case isDatabaseAvailable && !isDatabaseRunning:
+		// Update running annotation.
+		log.Info("Update database resource running annotation")
+
+		helpers.SetDatabaseIsRunning(object)
+		triggerUpdate = true
+	}
+
+	// Apply triggered resource updates with short delay requeue.
+	switch {
+	case triggerStatusUpdate:
+		err := r.Status().Update(ctx, object)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "update database resource status")
+		}
+		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
+
+	case triggerUpdate:
+		err := r.Update(ctx, object)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "update database resource")
+		}
+		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
+	}
+
+	// Read secret token.
+	secretManager := helpers.NewSecretManager(r.Client, object)
+
+	authSecret := domain.Secret{Namespace: req.Namespace, Name: spec.AuthSecret.Name, Key: spec.AuthSecret.Key}
+	log.Info("Get auth secret", "secret", authSecret)
+
+	apiToken, err := secretManager.GetSecret(ctx, authSecret)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(ctx, err, "get auth secret")
+	}
+
+	// Create database manager.
+	newDatabaseManager := r.NewDatabaseManager
+	if newDatabaseManager == nil {
+		newDatabaseManager = databasebase.NewManager
+	}
+	dbManager, err := newDatabaseManager(ctx, r.Engine.DatabaseType(), apiToken, spec.Region, spec.AppID)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(ctx, err, "create database manager")
+	}
+
+	// The connection information is shared by the secret and the app env targets, without requesting the
+	// Scalingo API twice.
+	getConnInfo := func() (connInfo, error) {
+		if currentConnInfo == nil {
+			info, err := r.composeConnInfo(ctx, dbManager, object, currentDB)
+			if err != nil {
+				return connInfo{}, err
+			}
+			currentConnInfo = &info
+		}
+		return *currentConnInfo, nil
+	}
+
+	// Requested/expected database resource.
+	expectedDB, err := r.Engine.ToDatabase(ctx, object)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(ctx, err, "bad custom resource format")
+	}
+
+	if spec.ProjectRef != nil && !isDatabaseDeletionRequested {
+		projectResolver := helpers.ProjectResolver{Client: r.Client}
+		expectedDB.ProjectID, err = projectResolver.ResolveProjectID(ctx, req.Namespace, *spec.ProjectRef)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "resolve project reference")
+		}
+	}
+
+	// The firewall rule sets are not resolved on deletion, so that a rule set deleted first, e.g. with its
+	// namespace, does not block the deletion of the database.
+	if spec.Networking.Firewall != nil && len(spec.Networking.Firewall.RuleSets) > 0 && !isDatabaseDeletionRequested {
+		firewallRuleSetResolver := networking.FirewallRuleSetResolver{Client: r.Client}
+		ruleSetRules, err := firewallRuleSetResolver.ResolveRules(ctx, req.Namespace, spec.Networking.Firewall.RuleSets)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "resolve firewall rule sets")
+		}
+
+		rules, err := adapters.ToFirewallRules(ctx, ruleSetRules)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "bad firewall rule set format")
+		}
+		expectedDB.FireWallRules = domain.DeduplicateFirewallRules(append(expectedDB.FireWallRules, rules...))
+	}
+
+	// The cluster egress IPs are not resolved on deletion, so that a deleted ConfigMap or a cluster without
+	// node ExternalIP does not block the deletion of the database.
+	if domain.HasClusterEgressFirewallRule(expectedDB.FireWallRules) && !isDatabaseDeletionRequested {
+		var clusterEgressSpec *apiv1.ClusterEgressSpec
+		if spec.Networking.Firewall != nil {
+			clusterEgressSpec = spec.Networking.Firewall.ClusterEgress
+		}
+
+		clusterEgressResolver := networking.ClusterEgressResolver{Client: r.Client}
+		clusterEgressIPs, err := clusterEgressResolver.ResolveIPs(ctx, req.Namespace, clusterEgressSpec)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "resolve cluster egress ips")
+		}
+		expectedDB.FireWallRules = domain.ExpandClusterEgressFirewallRules(expectedDB.FireWallRules, clusterEgressIPs)
+	}
+
+	// Resolve the managed ranges referenced by name, which are only listed for an existing database.
+	if status.ScalingoDatabaseID != "" && !isDatabaseDeletionRequested {
+		hasFirewallManagedRangeNames := domain.HasFirewallManagedRangeName(expectedDB.FireWallRules)
+
+		rules, managedRanges, err := dbManager.ResolveFirewallManagedRanges(ctx, status.ScalingoDatabaseID, expectedDB.FireWallRules)
+		if errors.Is(err, domain.ErrFirewallManagedRangeNotFound) {
+			log.Error(err, "Resolve firewall managed ranges")
+
+			helpers.SetFirewallManagedRangesStatus(&status.Conditions, hasFirewallManagedRangeNames, err, object.GetGeneration())
+			status.FirewallManagedRanges = nil
+			statusErr := r.Status().Update(ctx, object)
+			if statusErr != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, statusErr, "update database resource status")
+			}
+			return ctrl.Result{}, errors.Wrap(ctx, err, "resolve firewall managed ranges")
+		} else if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "resolve firewall managed ranges")
+		}
+		expectedDB.FireWallRules = rules
+
+		managedRangesStatus := adapters.ToFirewallManagedRangesStatus(managedRanges)
+		isConditionChanged := helpers.SetFirewallManagedRangesStatus(&status.Conditions, hasFirewallManagedRangeNames, nil, object.GetGeneration())
+		if isConditionChanged || !slices.Equal(managedRangesStatus, status.FirewallManagedRanges) {
+			status.FirewallManagedRanges = managedRangesStatus
+			triggerStatusUpdate = true
+		}
+	}
+
+	log.Info("Current state",
+		"database", status.ScalingoDatabaseID,
+		"deletion_requested", isDatabaseDeletionRequested,
+		"available", isDatabaseAvailable,
+		"provisioning", isDatabaseProvisioning,
+		"running", isDatabaseRunning)
+
+	isOutscaleNetPeeringEnabled := spec.Networking.IsOutscaleNetPeeringEnabled()
+	netPeeringReconciler := networking.NetPeeringReconciler{
+		Client: r.Client,
+		Scheme: r.Scheme,
+	}
+	netPeeringResource := networking.DatabaseResource{
+		Name:        object.GetName(),
+		Namespace:   object.GetNamespace(),
+		Owner:       object,
+		DatabaseID:  status.ScalingoDatabaseID,
+		Networking:  spec.Networking,
+		NetPeerings: status.NetPeerings,
+	}
+
+	// Create/update/delete database.
+	switch {
+	case isDatabaseDeletionRequested:
+		log.Info("Delete database")
+		shouldDeleteResources := true
+
+		if status.ScalingoDatabaseID == "" {
+			log.Info("Database provisioning requested but no database created yet, skip database deletion")
+		} else {
+			ok, err := dbManager.CheckDatabaseExists(ctx, status.ScalingoDatabaseID)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(ctx, err, "check database %s exists", status.ScalingoDatabaseID)
+			}
+			if !ok {
+				shouldDeleteResources = false
+				log.Info("Scalingo database not found, skip database deletion", "database", status.ScalingoDatabaseID)
+			}
+
+			if shouldDeleteResources {
+				if isOutscaleNetPeeringEnabled || len(status.NetPeerings) > 0 {
+					err = netPeeringReconciler.DeleteNetPeerings(ctx, dbManager, netPeeringResource)
+					if err != nil {
+						return ctrl.Result{}, errors.Wrap(ctx, err, "delete net peering resources")
+					}
+				}
+
+				err = dbManager.DeleteDatabase(ctx, status.ScalingoDatabaseID)
+				if err != nil {
+					return ctrl.Result{}, errors.Wrapf(ctx, err, "delete database id %s", status.ScalingoDatabaseID)
+				}
+			}
+		}
+
+		// The variables written in the environment of the Scalingo applications must be unset.
+		err = r.unsetAppEnvTargets(ctx, dbManager, status.AppEnvTargets)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "unset app env targets")
+		}
+
+		// The Kubernetes secret is garbage collected with its owner, the Vault secret must be deleted. The
+		// resources written before the Vault secret was tracked fall back on the spec.
+		vaultSecret := status.ConnInfoVaultSecret
+		if vaultSecret == nil {
+			vaultSecret = spec.ConnInfoSecretTarget.Vault
+		}
+		err = helpers.DeleteConnInfoVaultSecret(ctx, secretManager, object.GetNamespace(), vaultSecret)
+		if apierrors.IsNotFound(err) {
+			log.Info("Vault token secret not found, skip vault secret deletion")
+		} else if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "delete vault connection info secret")
+		}
+
+		controllerutil.RemoveFinalizer(object, r.Engine.FinalizerName())
+		err = r.Update(ctx, object)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "remove resource finalizer")
+		}
+
+	case !isDatabaseAvailable && status.ScalingoDatabaseID == "":
+		log.Info("Create database")
+
+		newDB, err := dbManager.CreateDatabase(ctx, expectedDB)
+		if err != nil {
+			log.Error(err, "Create database", "database", expectedDB)
+			return ctrl.Result{}, errors.Wrapf(ctx, err, "create database %s", expectedDB.Name)
+		}
+
+		status.ScalingoDatabaseID = newDB.ID
+		status.ProjectID = newDB.ProjectID
+		helpers.SetDatabaseStatusProvisioning(&status.Conditions)
+		triggerStatusUpdate = true
+		triggerRequeueLater = helpers.RequeueLongDelay
+
+	case isDatabaseAvailable && !isDatabaseProvisioning && status.ScalingoDatabaseID != "":
+		log.Info("Update database")
+
+		dbStatus, err := dbManager.UpdateDatabase(ctx, status.ScalingoDatabaseID, expectedDB)
+		if err != nil {
+			log.Error(err, "Update database", "database", expectedDB)
+			return ctrl.Result{}, errors.Wrapf(ctx, err, "update database %s", expectedDB.Name)
+		}
+
+		if dbStatus == domain.DatabaseStatusProvisioning {
+			log.Info("Waiting for database being provisioned")
+			helpers.SetDatabaseStatusProvisioning(&status.Conditions)
+			triggerStatusUpdate = true
+		}
+
+	case isDatabaseProvisioning && status.ScalingoDatabaseID != "":
+		// Keep applying compatible updates (e.g firewall rules) while the database is
+		// available (created) and provisioning.
+		if isDatabaseAvailable {
+			_, err := dbManager.UpdateDatabase(ctx, status.ScalingoDatabaseID, expectedDB)
+			if err != nil {
+				log.Error(err, "Update database while provisioning", "database", expectedDB)
+				return ctrl.Result{}, errors.Wrapf(ctx, err, "update database %s while provisioning", expectedDB.Name)
+			}
+		}
+
+		// Wait for database creation/plan update completion.
+		currentDB, err = dbManager.GetDatabase(ctx, status.ScalingoDatabaseID)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(ctx, err, "get current database %s", status.ScalingoDatabaseID)
+		}
+
+		if currentDB.Status == domain.DatabaseStatusRunning {
+			log.Info("Database is provisioned")
+
+			helpers.SetDatabaseStatusProvisioned(&status.Conditions)
+			status.ProjectID = currentDB.ProjectID
+			triggerStatusUpdate = true
+
+			// Write connection info in secret
+			info, err := getConnInfo()
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "compose connection info")
+			}
+			err = r.writeConnInfoSecrets(ctx, secretManager, object, info)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "write connection info secrets")
+			}
+			isConnInfoWritten = true
+			triggerRequeueLater = helpers.RequeueShortDelay // Requeue for the is running annotation.
+
+		} else {
+			log.Info("Waiting for database being provisioned")
+			triggerRequeueLater = helpers.RequeueLongDelay
+		}
+	}
+
+	netPeeringResource.DatabaseID = status.ScalingoDatabaseID
+	netPeerings, netPeeringRequeue, netPeeringErr := netPeeringReconciler.Reconcile(
+		ctx,
+		dbManager,
+		netPeeringResource,
+		networking.DatabaseState{
+			DeletionRequested: isDatabaseDeletionRequested,
+			Available:         isDatabaseAvailable,
+			Provisioning:      isDatabaseProvisioning,
+		},
+	)
+	if !isDatabaseDeletionRequested {
+		isConditionChanged := helpers.SetNetPeeringReadyStatus(&status.Conditions, isOutscaleNetPeeringEnabled, netPeerings, netPeeringErr, object.GetGeneration())
+		if isConditionChanged || !equality.Semantic.DeepEqual(netPeerings, status.NetPeerings) {
+			status.NetPeerings = netPeerings
+			triggerStatusUpdate = true
+		}
+	}
+	if netPeeringErr != nil {
+		// Report the failure, the reconciliation is retried with backoff.
+		if triggerStatusUpdate {
+			statusErr := r.Status().Update(ctx, object)
+			if statusErr != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, statusErr, "update database resource status")
+			}
+		}
+		return ctrl.Result{}, netPeeringErr
+	}
+	if netPeeringRequeue > 0 {
+		triggerRequeueLater = netPeeringRequeue
+	}
+
+	isDatabaseProvisioned := helpers.IsDatabaseAvailable(status.Conditions) &&
+		!helpers.IsDatabaseProvisioning(status.Conditions)
+
+	// Observe the provisioned database once, unless just fetched to complete its provisioning.
+	if !isDatabaseDeletionRequested && isDatabaseProvisioned && currentDB.ID == "" {
+		currentDB, err = dbManager.GetDatabase(ctx, status.ScalingoDatabaseID)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(ctx, err, "get current database %s", status.ScalingoDatabaseID)
+		}
+	}
+
+	// Rewrite the connection info when the preferred endpoint is not applied yet, e.g. while waiting for the
+	// private endpoint of the net peering, or after the preferred endpoint changed, when the CA certificate
+	// was not available, and when the Vault target changed.
+	preferredEndpoint := spec.ConnInfoSecretTarget.PreferredEndpoint
+	if !isConnInfoWritten && !isDatabaseDeletionRequested && isDatabaseProvisioned &&
+		(!helpers.IsPreferredEndpointApplied(status.Conditions, preferredEndpoint, object.GetGeneration()) ||
+			helpers.IsCACertificateNotAvailable(status.Conditions) ||
+			!equality.Semantic.DeepEqual(status.ConnInfoVaultSecret, spec.ConnInfoSecretTarget.Vault)) {
+		log.Info("Apply preferred endpoint", "endpoint", preferredEndpoint)
+
+		info, err := getConnInfo()
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "compose connection info")
+		}
+		err = r.writeConnInfoSecrets(ctx, secretManager, object, info)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "write connection info secrets")
+		}
+		triggerStatusUpdate = true
+
+		if !helpers.IsPreferredEndpointApplied(status.Conditions, preferredEndpoint, object.GetGeneration()) && triggerRequeueLater == 0 {
+			log.Info("Waiting for preferred endpoint", "endpoint", preferredEndpoint)
+			triggerRequeueLater = helpers.RequeueLongDelay
+		}
+		if helpers.IsCACertificateNotAvailable(status.Conditions) && triggerRequeueLater == 0 {
+			log.Info("Waiting for CA certificate")
+			triggerRequeueLater = helpers.RequeueLongDelay
+		}
+	}
+
+	// Move the database to the expected project, comparing with the observed project so that the moves made
+	// outside of the operator are reverted. A rejected move, e.g. because of a private network mismatch, is
+	// reported in the status and retried later.
+	if !isDatabaseDeletionRequested && isDatabaseProvisioned {
+		var projectErr error
+		projectID := currentDB.ProjectID
+		if expectedDB.ProjectID != "" && expectedDB.ProjectID != projectID {
+			log.Info("Ensure database project", "project", expectedDB.ProjectID, "currentProject", projectID)
+
+			projectID, err = dbManager.EnsureDatabaseProject(ctx, status.ScalingoDatabaseID, expectedDB.ProjectID)
+			if errors.Is(err, domain.ErrDatabaseProjectMoveRejected) {
+				log.Error(err, "Move database to project", "project", expectedDB.ProjectID)
+				projectErr = err
+				if triggerRequeueLater == 0 {
+					triggerRequeueLater = helpers.RequeueLongDelay
+				}
+			} else if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "ensure database project")
+			}
+		}
+
+		if projectID != status.ProjectID {
+			status.ProjectID = projectID
+			triggerStatusUpdate = true
+		}
+
+		if helpers.SetProjectAppliedStatus(&status.Conditions, expectedDB.ProjectID, projectErr, object.GetGeneration()) {
+			triggerStatusUpdate = true
+		}
+	}
+
+	// Point the in-cluster Services at the database endpoints.
+	// The Services are refreshed periodically to follow the endpoints changes, and only looked up once disabled
+	// when some are left.
+	isEndpointServicesEnabled := spec.Networking.IsEndpointServicesEnabled()
+	if !isDatabaseDeletionRequested && isDatabaseProvisioned && (isEndpointServicesEnabled || len(status.EndpointServices) > 0) {
+		var endpoints []domain.DatabaseEndpoint
+		if isEndpointServicesEnabled {
+			endpoints, err = dbManager.GetDatabaseEndpoints(ctx, status.ScalingoDatabaseID)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "get database endpoints")
+			}
+		}
+
+		serviceManager := helpers.NewServiceManager(r.Client, object)
+		err = serviceManager.SetEndpointServices(ctx, endpoints)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "set endpoint services")
+		}
+
+		endpointServices := helpers.ComposeEndpointServiceNames(object.GetName(), endpoints)
+		if !slices.Equal(endpointServices, status.EndpointServices) {
+			status.EndpointServices = endpointServices
+			triggerStatusUpdate = true
+		}
+		if isEndpointServicesEnabled {
+			triggerRequeueLater = helpers.MinRequeueDelay(triggerRequeueLater, helpers.RequeueRefreshDelay)
+		}
+	}
+
+	// Allow egress from the selected pods to the database endpoints.
+	if !isDatabaseDeletionRequested && isDatabaseProvisioned {
+		networkPolicyManager := helpers.NewNetworkPolicyManager(r.Client, object)
+
+		if spec.Networking.EgressNetworkPolicy != nil {
+			endpoints, err := dbManager.GetDatabaseEndpoints(ctx, status.ScalingoDatabaseID)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "get database endpoints")
+			}
+
+			netConfig, err := dbManager.GetDatabaseNetworkConfiguration(ctx, status.ScalingoDatabaseID)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "get database network configuration")
+			}
+
+			egressPeers, err := helpers.ComposeEgressPeers(ctx, net.DefaultResolver, endpoints, netConfig.IPRange)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "compose egress peers")
+			}
+
+			if len(egressPeers) > 0 {
+				log.Info("Write egress network policy", "peers", egressPeers)
+				err = networkPolicyManager.SetEgressNetworkPolicy(ctx, spec.Networking.EgressNetworkPolicy.PodSelector, egressPeers)
+				if err != nil {
+					return ctrl.Result{}, errors.Wrap(ctx, err, "set egress network policy")
+				}
+			} else {
+				// Do not keep allowing the addresses of endpoints which no longer exist.
+				log.Info("Delete egress network policy without peer")
+				err = networkPolicyManager.DeleteEgressNetworkPolicy(ctx)
+				if err != nil {
+					return ctrl.Result{}, errors.Wrap(ctx, err, "delete egress network policy")
+				}
+			}
+
+			// The public endpoints addresses are resolved again periodically, as they may change.
+			triggerRequeueLater = helpers.MinRequeueDelay(triggerRequeueLater, helpers.RequeueRefreshDelay)
+		} else {
+			err = networkPolicyManager.DeleteEgressNetworkPolicy(ctx)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "delete egress network policy")
+			}
+		}
+	}
+
+	// Delete the metadata config map previously written when its target is removed or renamed.
+	if status.MetadataConfigMapName != "" && !isDatabaseDeletionRequested &&
+		(spec.MetadataConfigMapTarget == nil || spec.MetadataConfigMapTarget.Name != status.MetadataConfigMapName) {
+		log.Info("Delete database metadata config map", "configMap", status.MetadataConfigMapName)
+
+		configMapManager := helpers.NewConfigMapManager(r.Client, object)
+		err := configMapManager.DeleteConfigMap(ctx, req.Namespace, status.MetadataConfigMapName)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(ctx, err, "delete config map %s", status.MetadataConfigMapName)
+		}
+		status.MetadataConfigMapName = ""
+		triggerStatusUpdate = true
+	}
+
+	// Publish the database metadata once the database is provisioned.
+	if spec.MetadataConfigMapTarget != nil && !isDatabaseDeletionRequested && isDatabaseProvisioned {
+		metadata, err := dbManager.GetDatabaseMetadata(ctx, status.ScalingoDatabaseID)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "get database metadata")
+		}
+
+		metadataConfigMapTarget := spec.MetadataConfigMapTarget
+		log.Info("Write database metadata config map", "configMap", metadataConfigMapTarget.Name)
+
+		configMapManager := helpers.NewConfigMapManager(r.Client, object)
+		err = configMapManager.SetConfigMapData(ctx, req.Namespace, metadataConfigMapTarget.Name, metadata.Data(metadataConfigMapTarget.Prefix))
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(ctx, err, "set config map %s", metadataConfigMapTarget.Name)
+		}
+		if status.MetadataConfigMapName != metadataConfigMapTarget.Name {
+			status.MetadataConfigMapName = metadataConfigMapTarget.Name
+			triggerStatusUpdate = true
+		}
+	}
+
+	// Write the connection information in the environment of the Scalingo applications at each reconciliation,
+	// and periodically, to keep the variables up to date when the connection information changes, e.g. after a
+	// password reset. The variables of the removed targets are unset.
+	if (len(spec.AppEnvTargets) > 0 || len(status.AppEnvTargets) > 0) && !isDatabaseDeletionRequested && isDatabaseProvisioned {
+		err = r.unsetAppEnvTargets(ctx, dbManager, helpers.FindRemovedAppEnvTargets(status.AppEnvTargets, spec.AppEnvTargets))
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "unset removed app env targets")
+		}
+
+		// Track the targets before writing them, so that a variable partially written is unset once removed.
+		appEnvTargets := helpers.ToAppEnvTargetStatuses(spec.AppEnvTargets)
+		if !equality.Semantic.DeepEqual(appEnvTargets, status.AppEnvTargets) {
+			status.AppEnvTargets = appEnvTargets
+			triggerStatusUpdate = true
+		}
+
+		if len(spec.AppEnvTargets) > 0 {
+			info, err := getConnInfo()
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, err, "compose connection info")
+			}
+			err = r.writeAppEnvTargets(ctx, dbManager, object, info)
+			if err != nil {
+				// Keep track of the targets, the reconciliation is retried with backoff.
+				if triggerStatusUpdate {
+					statusErr := r.Status().Update(ctx, object)
+					if statusErr != nil {
+						return ctrl.Result{}, errors.Wrap(ctx, statusErr, "update database resource status")
+					}
+				}
+				return ctrl.Result{}, errors.Wrap(ctx, err, "write app env targets")
+			}
+			triggerRequeueLater = helpers.MinRequeueDelay(triggerRequeueLater, helpers.RequeueRefreshDelay)
+		}
+	}
+
+	// Update the status fields specific to the engine, refreshed periodically.
+	statusUpdater, hasEngineStatus := r.Engine.(DatabaseEngineStatusUpdater)
+	if hasEngineStatus && !isDatabaseDeletionRequested && isDatabaseProvisioned {
+		isStatusChanged, err := statusUpdater.UpdateEngineStatus(ctx, dbManager, object, currentDB)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "update engine status")
+		}
+		if isStatusChanged {
+			triggerStatusUpdate = true
+		}
+		triggerRequeueLater = helpers.MinRequeueDelay(triggerRequeueLater, helpers.RequeueRefreshDelay)
+	}
+
+	// Apply triggered resource updates and requeue later.
+	if triggerStatusUpdate {
+		log.Info("Update resource status", "statusConditions", status.Conditions)
+		err := r.Status().Update(ctx, object)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "update database resource status")
+		}
+	}
+	if triggerRequeueLater > 0 {
+		log.Info("Requeue after", "delay", triggerRequeueLater)
+		return ctrl.Result{RequeueAfter: triggerRequeueLater}, nil
+	}
+
+	log.Info("Ready")
+	return ctrl.Result{}, nil
+}
+
+// writeConnInfoSecrets writes the connection information of the database in the secret target, deletes the
+// Vault secret previously written elsewhere, and sets the preferred endpoint status condition.
+func (r *DatabaseReconciler) writeConnInfoSecrets(ctx context.Context, secretManager *helpers.SecretManager, object DatabaseObject, info connInfo) error {
+	log := logf.FromContext(ctx)
+	target := object.GetDatabaseSpec().ConnInfoSecretTarget
+
+	connInfoSinks, err := helpers.NewConnInfoSecretSinks(ctx, secretManager, object.GetNamespace(), target)
+	if err != nil {
+		return errors.Wrap(ctx, err, "new connection info secret sinks")
+	}
+
+	for _, connInfoSecret := range info.secrets {
+		log.Info("Write connection info secret", "secret", connInfoSecret)
+	}
+	err = connInfoSinks.SetSecrets(ctx, info.secrets)
+	if err != nil {
+		return errors.Wrap(ctx, err, "set connection info secrets")
+	}
+
+	// Delete the Vault secret previously written once the connection info is written in its new target.
+	status := object.GetDatabaseStatus()
+	if !helpers.IsSameVaultSecret(status.ConnInfoVaultSecret, target.Vault) {
+		err = helpers.DeleteConnInfoVaultSecret(ctx, secretManager, object.GetNamespace(), status.ConnInfoVaultSecret)
+		if err != nil {
+			return errors.Wrap(ctx, err, "delete previous vault connection info secret")
+		}
+	}
+	status.ConnInfoVaultSecret = target.Vault.DeepCopy()
+
+	_, isPreferredEndpointFound := helpers.FindPreferredEndpoint(target, info.endpoints)
+	helpers.SetPreferredEndpointStatus(&object.GetDatabaseStatus().Conditions, target.PreferredEndpoint, isPreferredEndpointFound, object.GetGeneration())
+	return nil
+}
+
+// writeAppEnvTargets writes the connection information of the database in the environment variables of the
+// Scalingo applications targets. The variables whose value did not change are left as is.
+func (r *DatabaseReconciler) writeAppEnvTargets(ctx context.Context, dbManager databaseusecases.Manager, object DatabaseObject, info connInfo) error {
+	log := logf.FromContext(ctx)
+	spec := object.GetDatabaseSpec()
+
+	mainURLKey := domain.ComposeConnectionURLName(spec.ConnInfoSecretTarget.Prefix, info.dbURL.Name)
+
+	for _, target := range spec.AppEnvTargets {
+		value, err := helpers.FindAppEnvTargetValue(ctx, target, mainURLKey, info.secrets)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "find value of app %s variable %s", target.AppID, target.Variable)
+		}
+
+		isChanged, err := dbManager.EnsureAppVariable(ctx, target.AppID, target.Variable, value)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "ensure app %s variable %s", target.AppID, target.Variable)
+		}
+		if isChanged {
+			log.Info("Write connection info in app environment", "app", target.AppID, "variable", target.Variable)
+		}
+	}
+	return nil
+}
+
+// unsetAppEnvTargets unsets the environment variables written in the Scalingo applications.
+func (r *DatabaseReconciler) unsetAppEnvTargets(ctx context.Context, dbManager databaseusecases.Manager, statuses []apiv1.AppEnvTargetStatus) error {
+	log := logf.FromContext(ctx)
+
+	for _, status := range statuses {
+		log.Info("Unset connection info in app environment", "app", status.AppID, "variable", status.Variable)
+
+		err := dbManager.UnsetAppVariable(ctx, status.AppID, status.Variable)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "unset app %s variable %s", status.AppID, status.Variable)
+		}
+	}
+	return nil
+}
+
+// connInfo is the connection information of the database.
+type connInfo struct {
+	secrets   []domain.Secret
+	dbURL     domain.DatabaseURL
+	endpoints []domain.DatabaseEndpoint
+}
+
+// composeConnInfo returns the connection information secrets of the database, along with its URL and
+// endpoints.
+func (r *DatabaseReconciler) composeConnInfo(ctx context.Context, dbManager databaseusecases.Manager, object DatabaseObject, db domain.Database) (connInfo, error) {
+	dbURL, err := dbManager.GetDatabaseURL(ctx, db)
+	if err != nil {
+		return connInfo{}, errors.Wrap(ctx, err, "get database url")
+	}
+
+	// The connection information is still written without CA certificate, the missing CA certificate is
+	// reported in the status.
+	caCertificate, caCertificateErr := dbManager.GetDatabaseCACertificate(ctx, db)
+	if caCertificateErr != nil {
+		logf.FromContext(ctx).Error(caCertificateErr, "Get database CA certificate")
+	}
+	helpers.SetCACertificateAvailableStatus(&object.GetDatabaseStatus().Conditions, caCertificateErr, object.GetGeneration())
+
+	endpoints, err := dbManager.GetDatabaseEndpoints(ctx, db.ID)
+	if err != nil {
+		return connInfo{}, errors.Wrap(ctx, err, "get database endpoints")
+	}
+
+	target := object.GetDatabaseSpec().ConnInfoSecretTarget
+	connInfoSecrets, err := helpers.ComposeConnInfoSecrets(ctx, object.GetNamespace(), target, r.Engine.DatabaseType(), dbURL, caCertificate, endpoints)
+	if err != nil {
+		return connInfo{}, errors.Wrap(ctx, err, "compose connection info secrets")
+	}
+	return connInfo{secrets: connInfoSecrets, dbURL: dbURL, endpoints: endpoints}, nil
+}
+
+// SetupWithManager sets up the controller of the engine with the Manager.
+func (r *DatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.Engine.NewObject()).
+		Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterEgressRequests),
+			builder.WithPredicates(networking.NodeExternalIPsChangedPredicate()),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterEgressRequests),
+		).
+		Watches(
+			&apiv1.FirewallRuleSet{},
+			handler.EnqueueRequestsFromMapFunc(r.firewallRuleSetRequests),
+		).
+		Watches(
+			&apiv1.ScalingoProject{},
+			handler.EnqueueRequestsFromMapFunc(r.scalingoProjectRequests),
+		).
+		Named(string(r.Engine.DatabaseType())).
+		Complete(r)
+}
+
+// listObjects lists the database resources of the engine.
+func (r *DatabaseReconciler) listObjects(ctx context.Context, opts ...client.ListOption) ([]DatabaseObject, error) {
+	list := r.Engine.NewObjectList()
+	err := r.List(ctx, list, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "list database resources")
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "extract database resources")
+	}
+
+	objects := make([]DatabaseObject, 0, len(items))
+	for _, item := range items {
+		object, ok := item.(DatabaseObject)
+		if !ok {
+			return nil, errors.Newf(ctx, "unexpected database resource type %T", item)
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// clusterEgressRequests returns the resources whose cluster egress firewall rules depend on the given node or
+// config map.
+func (r *DatabaseReconciler) clusterEgressRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	objects, err := r.listObjects(ctx)
+	if err != nil {
+		log.Error(err, "List resources using cluster egress")
+		return nil
+	}
+
+	firewallRuleSetResolver := networking.FirewallRuleSetResolver{Client: r.Client}
+
+	var requests []reconcile.Request
+	for _, object := range objects {
+		networkingSpec := object.GetDatabaseSpec().Networking
+
+		// The cluster egress rules may come from the referenced FirewallRuleSet resources. A missing rule set
+		// fails the reconciliation of the resource, which is retried anyway.
+		var ruleSetRules []apiv1.FirewallRuleSpec
+		if networkingSpec.Firewall != nil && len(networkingSpec.Firewall.RuleSets) > 0 {
+			ruleSetRules, err = firewallRuleSetResolver.ResolveRules(ctx, object.GetNamespace(), networkingSpec.Firewall.RuleSets)
+			if err != nil {
+				log.Error(err, "Resolve firewall rule sets", "resource", client.ObjectKeyFromObject(object))
+			}
+		}
+
+		var isDependent bool
+		switch obj.(type) {
+		case *corev1.Node:
+			isDependent = networking.UsesClusterEgressNodes(networkingSpec, ruleSetRules)
+		default:
+			isDependent = networking.UsesClusterEgressConfigMap(networkingSpec, ruleSetRules, object.GetNamespace(), obj)
+		}
+		if isDependent {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(object)})
+		}
+	}
+	return requests
+}
+
+// firewallRuleSetRequests returns the resources referencing the given firewall rule set.
+func (r *DatabaseReconciler) firewallRuleSetRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	objects, err := r.listObjects(ctx, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		log.Error(err, "List resources using firewall rule set")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, object := range objects {
+		if networking.UsesFirewallRuleSet(object.GetDatabaseSpec().Networking, object.GetNamespace(), obj) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(object)})
+		}
+	}
+	return requests
+}
+
+// scalingoProjectRequests returns the resources referencing the given Scalingo project.
+func (r *DatabaseReconciler) scalingoProjectRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	objects, err := r.listObjects(ctx, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		log.Error(err, "List resources using Scalingo project")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, object := range objects {
+		if helpers.UsesScalingoProject(*object.GetDatabaseSpec(), object.GetNamespace(), obj) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(object)})
+		}
+	}
+	return requests
+}
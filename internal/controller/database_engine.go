@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	databaseusecases "github.com/Scalingo/scalingo-operator/internal/usecases/database"
+)
+
+// DatabaseObject is a database custom resource, whatever its engine. Its spec and status embed the fields
+// shared by the engines.
+type DatabaseObject interface {
+	client.Object
+	GetDatabaseSpec() *apiv1.DatabaseSpec
+	GetDatabaseStatus() *apiv1.DatabaseStatus
+}
+
+// DatabaseEngine provides the engine specific parts of a database custom resource to the DatabaseReconciler.
+// The connection information secrets are composed by the reconciler, the engine specific keys and URL schemes
+// following from the capabilities of the DatabaseType.
+//
+// Adding an engine consists in implementing this interface next to the custom resource types, and registering
+// a DatabaseReconciler using it in the manager.
+type DatabaseEngine interface {
+	// DatabaseType returns the type of the Scalingo databases, also used as controller name.
+	DatabaseType() domain.DatabaseType
+
+	// FinalizerName returns the finalizer holding the resource until its database is deleted.
+	FinalizerName() string
+
+	// NewObject returns an empty custom resource of the engine.
+	NewObject() DatabaseObject
+
+	// NewObjectList returns an empty list of custom resources of the engine.
+	NewObjectList() client.ObjectList
+
+	// ToDatabase converts the custom resource to the expected database.
+	ToDatabase(ctx context.Context, object DatabaseObject) (domain.Database, error)
+}
+
+// DatabaseEngineStatusUpdater is implemented by the engines whose status has fields of their own, updated once
+// the database is provisioned.
+type DatabaseEngineStatusUpdater interface {
+	// UpdateEngineStatus updates the engine specific status fields of the custom resource from the current
+	// database, and returns whether they changed. It is called at each reconciliation of the provisioned database,
+	// and at least every helpers.RequeueRefreshDelay.
+	UpdateEngineStatus(ctx context.Context, dbManager databaseusecases.Manager, object DatabaseObject, db domain.Database) (bool, error)
+}
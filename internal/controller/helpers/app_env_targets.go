@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// FindAppEnvTargetValue returns the value of the connection information key of the target, or of the main
+// connection URL key when the target has no key.
+func FindAppEnvTargetValue(ctx context.Context, target apiv1.AppEnvTargetSpec, mainURLKey string, connInfoSecrets []domain.Secret) (string, error) {
+	key := target.Key
+	if key == "" {
+		key = mainURLKey
+	}
+
+	for _, connInfoSecret := range connInfoSecrets {
+		if connInfoSecret.Key == key {
+			return connInfoSecret.Value, nil
+		}
+	}
+	return "", errors.Newf(ctx, "connection information key %s not found", key)
+}
+
+// ToAppEnvTargetStatuses returns the statuses tracking the environment variables of the targets.
+func ToAppEnvTargetStatuses(targets []apiv1.AppEnvTargetSpec) []apiv1.AppEnvTargetStatus {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	statuses := make([]apiv1.AppEnvTargetStatus, 0, len(targets))
+	for _, target := range targets {
+		statuses = append(statuses, apiv1.AppEnvTargetStatus{AppID: target.AppID, Variable: target.Variable})
+	}
+	return statuses
+}
+
+// FindRemovedAppEnvTargets returns the written environment variables which are no longer targeted.
+func FindRemovedAppEnvTargets(statuses []apiv1.AppEnvTargetStatus, targets []apiv1.AppEnvTargetSpec) []apiv1.AppEnvTargetStatus {
+	var removed []apiv1.AppEnvTargetStatus
+	for _, status := range statuses {
+		isTargeted := slices.ContainsFunc(targets, func(target apiv1.AppEnvTargetSpec) bool {
+			return target.AppID == status.AppID && target.Variable == status.Variable
+		})
+		if !isTargeted {
+			removed = append(removed, status)
+		}
+	}
+	return removed
+}
@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestFindAppEnvTargetValue(t *testing.T) {
+	connInfoSecrets := []domain.Secret{
+		{Key: "SCALINGO_POSTGRESQL_URL", Value: "postgres://host:5432/db"},
+		{Key: "SCALINGO_POSTGRESQL_VERIFY_FULL_URL", Value: "postgres://host:5432/db?sslmode=verify-full"},
+	}
+
+	t.Run("it returns the main connection URL by default", func(t *testing.T) {
+		value, err := FindAppEnvTargetValue(t.Context(), apiv1.AppEnvTargetSpec{AppID: "my-app", Variable: "DATABASE_URL"}, "SCALINGO_POSTGRESQL_URL", connInfoSecrets)
+
+		require.NoError(t, err)
+		require.Equal(t, "postgres://host:5432/db", value)
+	})
+
+	t.Run("it returns the value of the target key", func(t *testing.T) {
+		target := apiv1.AppEnvTargetSpec{AppID: "my-app", Variable: "DATABASE_URL", Key: "SCALINGO_POSTGRESQL_VERIFY_FULL_URL"}
+
+		value, err := FindAppEnvTargetValue(t.Context(), target, "SCALINGO_POSTGRESQL_URL", connInfoSecrets)
+
+		require.NoError(t, err)
+		require.Equal(t, "postgres://host:5432/db?sslmode=verify-full", value)
+	})
+
+	t.Run("it fails when the key is unknown", func(t *testing.T) {
+		target := apiv1.AppEnvTargetSpec{AppID: "my-app", Variable: "DATABASE_URL", Key: "UNKNOWN_KEY"}
+
+		_, err := FindAppEnvTargetValue(t.Context(), target, "SCALINGO_POSTGRESQL_URL", connInfoSecrets)
+
+		require.EqualError(t, err, "connection information key UNKNOWN_KEY not found")
+	})
+}
+
+func TestToAppEnvTargetStatuses(t *testing.T) {
+	t.Run("it tracks the app and variable of the targets", func(t *testing.T) {
+		targets := []apiv1.AppEnvTargetSpec{
+			{AppID: "my-app", Variable: "DATABASE_URL"},
+			{AppID: "my-app", Variable: "DATABASE_VERIFY_FULL_URL", Key: "SCALINGO_POSTGRESQL_VERIFY_FULL_URL"},
+		}
+
+		statuses := ToAppEnvTargetStatuses(targets)
+
+		require.Equal(t, []apiv1.AppEnvTargetStatus{
+			{AppID: "my-app", Variable: "DATABASE_URL"},
+			{AppID: "my-app", Variable: "DATABASE_VERIFY_FULL_URL"},
+		}, statuses)
+	})
+
+	t.Run("it returns nil without targets", func(t *testing.T) {
+		require.Nil(t, ToAppEnvTargetStatuses(nil))
+	})
+}
+
+func TestFindRemovedAppEnvTargets(t *testing.T) {
+	statuses := []apiv1.AppEnvTargetStatus{
+		{AppID: "my-app", Variable: "DATABASE_URL"},
+		{AppID: "my-worker", Variable: "DATABASE_URL"},
+	}
+
+	t.Run("it returns the variables no longer targeted", func(t *testing.T) {
+		targets := []apiv1.AppEnvTargetSpec{
+			{AppID: "my-app", Variable: "DATABASE_URL", Key: "SCALINGO_POSTGRESQL_VERIFY_FULL_URL"},
+			{AppID: "my-worker", Variable: "OTHER_DATABASE_URL"},
+		}
+
+		removed := FindRemovedAppEnvTargets(statuses, targets)
+
+		require.Equal(t, []apiv1.AppEnvTargetStatus{{AppID: "my-worker", Variable: "DATABASE_URL"}}, removed)
+	})
+
+	t.Run("it returns all the variables without targets", func(t *testing.T) {
+		require.Equal(t, statuses, FindRemovedAppEnvTargets(statuses, nil))
+	})
+}
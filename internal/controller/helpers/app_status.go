@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AppStatusConditionAvailable is the condition of the application resources whose application exists on
+	// Scalingo and is up to date with the spec.
+	AppStatusConditionAvailable = "Available"
+	// AppStatusConditionContainersScaled is the condition of the application resources whose container types
+	// all exist in the application formation, and are scaled.
+	AppStatusConditionContainersScaled = "ContainersScaled"
+)
+
+// SetAppAvailableStatus sets whether the application is available on Scalingo, and returns whether the
+// conditions changed.
+func SetAppAvailableStatus(conditions *[]metav1.Condition, isAvailable bool, generation int64) bool {
+	condition := metav1.Condition{
+		Type:               AppStatusConditionAvailable,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reasonAppAvailable,
+		Message:            msgAppAvailable,
+	}
+	if !isAvailable {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonAppNotAvailable
+		condition.Message = msgAppNotAvailable
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// SetAppContainersScaledStatus sets whether the container types of the spec are scaled, the missing ones from
+// the application formation being listed in the message, and returns whether the conditions changed.
+func SetAppContainersScaledStatus(conditions *[]metav1.Condition, missingContainerTypes []string, generation int64) bool {
+	condition := metav1.Condition{
+		Type:               AppStatusConditionContainersScaled,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reasonContainersScaled,
+		Message:            msgContainersScaled,
+	}
+	if len(missingContainerTypes) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonContainerTypesNotFound
+		condition.Message = fmt.Sprintf(msgContainerTypesNotFound, strings.Join(missingContainerTypes, ", "))
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// Private constants.
+const (
+	reasonAppAvailable           = "AppAvailable"
+	reasonAppNotAvailable        = "AppNotAvailable"
+	reasonContainersScaled       = "ContainersScaled"
+	reasonContainerTypesNotFound = "ContainerTypesNotFound"
+
+	msgAppAvailable           = "The application is available on Scalingo."
+	msgAppNotAvailable        = "The application is not yet available on Scalingo."
+	msgContainersScaled       = "The container types are scaled."
+	msgContainerTypesNotFound = "The container types %s are not in the application formation, e.g. not yet deployed: they are not scaled."
+)
@@ -0,0 +1,45 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetAppContainersScaledStatus(t *testing.T) {
+	t.Run("it sets the containers scaled", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		isChanged := SetAppContainersScaledStatus(&conditions, nil, 2)
+
+		require.True(t, isChanged)
+		condition := meta.FindStatusCondition(conditions, AppStatusConditionContainersScaled)
+		require.NotNil(t, condition)
+		require.Equal(t, metav1.ConditionTrue, condition.Status)
+		require.Equal(t, reasonContainersScaled, condition.Reason)
+		require.Equal(t, int64(2), condition.ObservedGeneration)
+	})
+
+	t.Run("it lists the container types missing from the formation", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		SetAppContainersScaledStatus(&conditions, []string{"worker", "clock"}, 1)
+
+		condition := meta.FindStatusCondition(conditions, AppStatusConditionContainersScaled)
+		require.NotNil(t, condition)
+		require.Equal(t, metav1.ConditionFalse, condition.Status)
+		require.Equal(t, reasonContainerTypesNotFound, condition.Reason)
+		require.Contains(t, condition.Message, "worker, clock")
+	})
+
+	t.Run("it does not change an up to date condition", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetAppContainersScaledStatus(&conditions, []string{"worker"}, 1)
+
+		isChanged := SetAppContainersScaledStatus(&conditions, []string{"worker"}, 1)
+
+		require.False(t, isChanged)
+	})
+}
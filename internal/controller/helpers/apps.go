@@ -0,0 +1,97 @@
+package helpers
+
+import (
+	"context"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// ResolveAppVariables returns the environment variables of the application, with the values read from the
+// referenced secrets of the namespace.
+func ResolveAppVariables(ctx context.Context, secretManager *SecretManager, namespace string, env []apiv1.AppEnvVarSpec) ([]domain.AppVariable, error) {
+	variables := make([]domain.AppVariable, 0, len(env))
+	for _, envVar := range env {
+		value := ""
+		switch {
+		case envVar.Value != nil:
+			value = *envVar.Value
+		case envVar.ValueFrom != nil:
+			secretRef := envVar.ValueFrom.SecretKeyRef
+			secretValue, err := secretManager.GetSecret(ctx, domain.Secret{Namespace: namespace, Name: secretRef.Name, Key: secretRef.Key})
+			if err != nil {
+				return nil, errors.Wrapf(ctx, err, "get secret of variable %s", envVar.Name)
+			}
+			value = secretValue
+		}
+
+		variables = append(variables, domain.AppVariable{Name: envVar.Name, Value: value})
+	}
+	return variables, nil
+}
+
+// MissingAppContainerTypes returns the names of the container types of the spec missing from the current
+// containers of the application, which can not be scaled.
+func MissingAppContainerTypes(containers []apiv1.AppContainerSpec, currentContainers []domain.AppContainer) []string {
+	var missingTypes []string
+	for _, container := range containers {
+		isFound := slices.ContainsFunc(currentContainers, func(current domain.AppContainer) bool {
+			return current.Name == container.Name
+		})
+		if !isFound {
+			missingTypes = append(missingTypes, container.Name)
+		}
+	}
+	return missingTypes
+}
+
+// AppEnvNames returns the sorted names of the environment variables.
+func AppEnvNames(env []apiv1.AppEnvVarSpec) []string {
+	if len(env) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(env))
+	for _, envVar := range env {
+		names = append(names, envVar.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// RemovedAppEnvNames returns the names of the variables set by the operator which are no longer in the env.
+func RemovedAppEnvNames(managedEnv []string, env []apiv1.AppEnvVarSpec) []string {
+	envNames := AppEnvNames(env)
+
+	var removedNames []string
+	for _, name := range managedEnv {
+		if !slices.Contains(envNames, name) {
+			removedNames = append(removedNames, name)
+		}
+	}
+	return removedNames
+}
+
+// AppUsesSecret returns whether the environment of the application reads the given secret.
+func AppUsesSecret(app apiv1.ScalingoApp, secret client.Object) bool {
+	if app.Namespace != secret.GetNamespace() {
+		return false
+	}
+
+	for _, envVar := range app.Spec.Env {
+		if envVar.ValueFrom != nil && envVar.ValueFrom.SecretKeyRef.Name == secret.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// AppUsesScalingoProject returns whether the application references the given ScalingoProject.
+func AppUsesScalingoProject(app apiv1.ScalingoApp, project client.Object) bool {
+	projectRef := app.Spec.ProjectRef
+	return projectRef != nil && app.Namespace == project.GetNamespace() && projectRef.Name == project.GetName()
+}
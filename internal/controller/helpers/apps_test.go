@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestResolveAppVariables(t *testing.T) {
+	secretManager := NewSecretManager(&appSecretClient{
+		secrets: []corev1.Secret{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "app-secrets", Namespace: "default"},
+				Data:       map[string][]byte{"api-key": []byte("secret-value")},
+			},
+		},
+	}, nil)
+
+	t.Run("it resolves the values and the secret references", func(t *testing.T) {
+		variables, err := ResolveAppVariables(t.Context(), secretManager, "default", []apiv1.AppEnvVarSpec{
+			{Name: "LOG_LEVEL", Value: ptr.To("debug")},
+			{Name: "API_KEY", ValueFrom: &apiv1.AppEnvVarSource{SecretKeyRef: apiv1.SecretKeyReference{Name: "app-secrets", Key: "api-key"}}},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.AppVariable{
+			{Name: "LOG_LEVEL", Value: "debug"},
+			{Name: "API_KEY", Value: "secret-value"},
+		}, variables)
+	})
+
+	t.Run("it fails when the secret does not exist", func(t *testing.T) {
+		_, err := ResolveAppVariables(t.Context(), secretManager, "default", []apiv1.AppEnvVarSpec{
+			{Name: "API_KEY", ValueFrom: &apiv1.AppEnvVarSource{SecretKeyRef: apiv1.SecretKeyReference{Name: "missing", Key: "api-key"}}},
+		})
+
+		require.ErrorContains(t, err, "get secret of variable API_KEY")
+	})
+}
+
+func TestRemovedAppEnvNames(t *testing.T) {
+	t.Run("it returns the managed variables removed from the env", func(t *testing.T) {
+		env := []apiv1.AppEnvVarSpec{{Name: "LOG_LEVEL", Value: ptr.To("debug")}}
+
+		require.Equal(t, []string{"OLD_VAR"}, RemovedAppEnvNames([]string{"LOG_LEVEL", "OLD_VAR"}, env))
+	})
+
+	t.Run("it returns nothing without managed variable", func(t *testing.T) {
+		require.Empty(t, RemovedAppEnvNames(nil, nil))
+	})
+}
+
+func TestMissingAppContainerTypes(t *testing.T) {
+	currentContainers := []domain.AppContainer{{Name: "web", Amount: 1}, {Name: "clock", Amount: 0}}
+
+	t.Run("it returns the container types missing from the formation", func(t *testing.T) {
+		containers := []apiv1.AppContainerSpec{{Name: "web", Amount: 2}, {Name: "worker", Amount: 1}, {Name: "clock", Amount: 1}}
+
+		require.Equal(t, []string{"worker"}, MissingAppContainerTypes(containers, currentContainers))
+	})
+
+	t.Run("it returns nothing when every container type exists", func(t *testing.T) {
+		containers := []apiv1.AppContainerSpec{{Name: "web", Amount: 2}}
+
+		require.Empty(t, MissingAppContainerTypes(containers, currentContainers))
+	})
+}
+
+func TestAppUsesSecret(t *testing.T) {
+	app := apiv1.ScalingoApp{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "default"},
+		Spec: apiv1.ScalingoAppSpec{
+			Env: []apiv1.AppEnvVarSpec{
+				{Name: "API_KEY", ValueFrom: &apiv1.AppEnvVarSource{SecretKeyRef: apiv1.SecretKeyReference{Name: "app-secrets", Key: "api-key"}}},
+			},
+		},
+	}
+
+	require.True(t, AppUsesSecret(app, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "app-secrets", Namespace: "default"}}))
+	require.False(t, AppUsesSecret(app, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "app-secrets", Namespace: "other"}}))
+	require.False(t, AppUsesSecret(app, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other-secrets", Namespace: "default"}}))
+}
+
+type appSecretClient struct {
+	client.Client
+
+	secrets []corev1.Secret
+}
+
+func (c *appSecretClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	for _, secret := range c.secrets {
+		if secret.Name == key.Name && secret.Namespace == key.Namespace {
+			secret.DeepCopyInto(obj.(*corev1.Secret))
+			return nil
+		}
+	}
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+}
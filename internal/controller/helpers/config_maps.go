@@ -0,0 +1,76 @@
+package helpers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+)
+
+type ConfigMapManager struct {
+	k8sClient          client.Client
+	databaseMetaObject metav1.Object
+}
+
+func NewConfigMapManager(k8sClient client.Client, databaseMetaObject metav1.Object) *ConfigMapManager {
+	return &ConfigMapManager{
+		k8sClient:          k8sClient,
+		databaseMetaObject: databaseMetaObject,
+	}
+}
+
+// SetConfigMapData creates or updates the config map, replacing its whole data.
+func (m ConfigMapManager) SetConfigMapData(ctx context.Context, namespace, name string, data map[string]string) error {
+	if namespace == "" {
+		return errors.New(ctx, "empty namespace")
+	}
+	if name == "" {
+		return errors.New(ctx, "empty name")
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, m.k8sClient, configMap, func() error {
+		configMap.Data = data
+
+		err := controllerutil.SetControllerReference(m.databaseMetaObject, configMap, m.k8sClient.Scheme())
+		if err != nil {
+			return errors.Wrap(ctx, err, "set controller reference on config map")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, "create or update config map")
+	}
+	return nil
+}
+
+// DeleteConfigMap deletes the config map, if it exists and is owned by the database resource.
+func (m ConfigMapManager) DeleteConfigMap(ctx context.Context, namespace, name string) error {
+	var configMap corev1.ConfigMap
+	err := m.k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &configMap)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(ctx, err, "get config map")
+	}
+	if !metav1.IsControlledBy(&configMap, m.databaseMetaObject) {
+		return nil
+	}
+
+	err = m.k8sClient.Delete(ctx, &configMap)
+	if client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(ctx, err, "delete config map")
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestConfigMapManager_SetConfigMapData(t *testing.T) {
+	t.Run("returns error when namespace is empty", func(t *testing.T) {
+		manager := NewConfigMapManager(nil, nil)
+
+		err := manager.SetConfigMapData(t.Context(), "", "my-config-map", nil)
+
+		require.EqualError(t, err, "empty namespace")
+	})
+
+	t.Run("returns error when name is empty", func(t *testing.T) {
+		manager := NewConfigMapManager(nil, nil)
+
+		err := manager.SetConfigMapData(t.Context(), "default", "", nil)
+
+		require.EqualError(t, err, "empty name")
+	})
+
+	t.Run("replaces the config map data and sets its owner", func(t *testing.T) {
+		ctx := t.Context()
+		scheme := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(scheme))
+
+		owner := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"}}
+		existing := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-config-map", Namespace: "default"},
+			Data:       map[string]string{"STALE_KEY": "stale"},
+		}
+		k8sClient := &configMapClient{scheme: scheme, configMap: existing}
+
+		manager := NewConfigMapManager(k8sClient, owner)
+		err := manager.SetConfigMapData(ctx, "default", "my-config-map", map[string]string{"PG_REGION": "osc-fr1"})
+		require.NoError(t, err)
+
+		configMap := k8sClient.configMap
+		require.Equal(t, map[string]string{"PG_REGION": "osc-fr1"}, configMap.Data)
+		require.Len(t, configMap.OwnerReferences, 1)
+		require.Equal(t, "owner", configMap.OwnerReferences[0].Name)
+	})
+}
+
+func TestConfigMapManager_DeleteConfigMap(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	owner := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"}}
+
+	t.Run("it does nothing when the config map does not exist", func(t *testing.T) {
+		manager := NewConfigMapManager(&configMapClient{scheme: scheme}, owner)
+
+		require.NoError(t, manager.DeleteConfigMap(t.Context(), "default", "my-config-map"))
+	})
+
+	t.Run("it keeps a config map which is not owned", func(t *testing.T) {
+		k8sClient := &configMapClient{scheme: scheme, configMap: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-config-map", Namespace: "default"},
+		}}
+		manager := NewConfigMapManager(k8sClient, owner)
+
+		require.NoError(t, manager.DeleteConfigMap(t.Context(), "default", "my-config-map"))
+		require.NotNil(t, k8sClient.configMap)
+	})
+
+	t.Run("it deletes the owned config map", func(t *testing.T) {
+		k8sClient := &configMapClient{scheme: scheme, configMap: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-config-map", Namespace: "default"},
+		}}
+		manager := NewConfigMapManager(k8sClient, owner)
+		require.NoError(t, manager.SetConfigMapData(t.Context(), "default", "my-config-map", map[string]string{"PG_REGION": "osc-fr1"}))
+
+		require.NoError(t, manager.DeleteConfigMap(t.Context(), "default", "my-config-map"))
+		require.Nil(t, k8sClient.configMap)
+	})
+}
+
+type configMapClient struct {
+	client.Client
+
+	scheme    *runtime.Scheme
+	configMap *corev1.ConfigMap
+}
+
+func (c *configMapClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if c.configMap == nil {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+	}
+	c.configMap.DeepCopyInto(obj.(*corev1.ConfigMap))
+	return nil
+}
+
+func (c *configMapClient) Delete(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+	c.configMap = nil
+	return nil
+}
+
+func (c *configMapClient) Update(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	c.configMap = obj.(*corev1.ConfigMap).DeepCopy()
+	return nil
+}
+
+func (c *configMapClient) Scheme() *runtime.Scheme {
+	return c.scheme
+}
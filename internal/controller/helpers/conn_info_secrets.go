@@ -0,0 +1,120 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// ComposeConnInfoSecrets returns the connection information secrets of a database: the main and per endpoint
+// connection URLs, their `sslmode=verify-full` or `rediss://` variants or HTTPS endpoints when the database
+// type supports them, the basic authentication credentials and the CA certificate needed to verify the server.
+// The main connection URL targets the preferred endpoint when available, the default database URL otherwise.
+// Without CA certificate, neither the CA certificate nor the `sslmode=verify-full` connection URLs are returned.
+func ComposeConnInfoSecrets(ctx context.Context, namespace string, target apiv1.SecretTargetSpec, dbType domain.DatabaseType, dbURL domain.DatabaseURL, caCertificate string, endpoints []domain.DatabaseEndpoint) ([]domain.Secret, error) {
+	newSecret := func(key, value string) domain.Secret {
+		return domain.Secret{
+			Namespace: namespace,
+			Name:      target.Name,
+			Key:       key,
+			Value:     value,
+		}
+	}
+
+	mainURL := dbURL.Value
+	preferredEndpoint, isPreferredEndpointFound := FindPreferredEndpoint(target, endpoints)
+	if isPreferredEndpointFound {
+		var err error
+		mainURL, err = domain.ComposeEndpointConnectionURL(ctx, dbURL.Value, preferredEndpoint)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "compose preferred endpoint connection url")
+		}
+	}
+
+	// Connection URLs come along with their `sslmode=verify-full` or `rediss://` variant or their HTTPS
+	// endpoint, when supported.
+	connectionURLSecrets := func(urlName, connectionURL string) ([]domain.Secret, error) {
+		switch {
+		case dbType.HasVerifyFullConnectionURL() && caCertificate != "":
+			verifyFullURL, err := domain.ComposeVerifyFullConnectionURL(ctx, connectionURL)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, "compose verify full connection url")
+			}
+			return []domain.Secret{
+				newSecret(urlName, connectionURL),
+				newSecret(domain.ComposeVerifyFullConnectionURLName(urlName), verifyFullURL),
+			}, nil
+
+		case dbType.HasTLSConnectionURL():
+			tlsURL, err := domain.ComposeTLSConnectionURL(ctx, connectionURL)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, "compose tls connection url")
+			}
+			return []domain.Secret{
+				newSecret(urlName, connectionURL),
+				newSecret(domain.ComposeTLSConnectionURLName(urlName), tlsURL),
+			}, nil
+
+		case dbType.HasBasicAuthCredentials():
+			httpsEndpoint, err := domain.ComposeHTTPSEndpoint(ctx, connectionURL)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, "compose https endpoint")
+			}
+			return []domain.Secret{
+				newSecret(urlName, connectionURL),
+				newSecret(domain.ComposeHTTPSEndpointName(urlName), httpsEndpoint),
+			}, nil
+
+		default:
+			return []domain.Secret{newSecret(urlName, connectionURL)}, nil
+		}
+	}
+
+	secrets, err := connectionURLSecrets(domain.ComposeConnectionURLName(target.Prefix, dbURL.Name), mainURL)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "compose main connection url secrets")
+	}
+	if dbType.HasBasicAuthCredentials() {
+		username, password, err := domain.ParseBasicAuthCredentials(ctx, dbURL.Value)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "parse basic auth credentials")
+		}
+		secrets = append(secrets,
+			newSecret(domain.ComposeUsernameName(target.Prefix, dbURL.Name), username),
+			newSecret(domain.ComposePasswordName(target.Prefix, dbURL.Name), password),
+		)
+	}
+	if caCertificate != "" {
+		secrets = append(secrets, newSecret(domain.ComposeCACertificateName(target.Prefix, dbURL.Name), caCertificate))
+	}
+
+	for _, endpoint := range endpoints {
+		endpointURL, err := domain.ComposeEndpointConnectionURL(ctx, dbURL.Value, endpoint)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "compose endpoint connection url")
+		}
+
+		endpointURLName := domain.ComposeEndpointConnectionURLName(target.Prefix, dbURL.Name, endpoint.Type)
+		endpointSecrets, err := connectionURLSecrets(endpointURLName, endpointURL)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "compose endpoint connection url secrets")
+		}
+		secrets = append(secrets, endpointSecrets...)
+	}
+	return secrets, nil
+}
+
+// FindPreferredEndpoint returns the endpoint matching the preferred endpoint of the secret target, if any.
+func FindPreferredEndpoint(target apiv1.SecretTargetSpec, endpoints []domain.DatabaseEndpoint) (domain.DatabaseEndpoint, bool) {
+	if target.PreferredEndpoint == "" {
+		return domain.DatabaseEndpoint{}, false
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.Type == domain.DatabaseEndpointType(target.PreferredEndpoint) {
+			return endpoint, true
+		}
+	}
+	return domain.DatabaseEndpoint{}, false
+}
@@ -3,4 +3,14 @@ package helpers
 const (
 	// Databases finalizers names.
 	PostgreSQLFinalizerName = "databases.scalingo.com/PostgresFinalizer"
+	MySQLFinalizerName      = "databases.scalingo.com/MySQLFinalizer"
+	RedisFinalizerName      = "databases.scalingo.com/RedisFinalizer"
+	MongoDBFinalizerName    = "databases.scalingo.com/MongoDBFinalizer"
+	OpenSearchFinalizerName = "databases.scalingo.com/OpenSearchFinalizer"
 )
+
+// ScalingoProjectFinalizerName holds the project resources until their Scalingo project is deleted.
+const ScalingoProjectFinalizerName = "databases.scalingo.com/ScalingoProjectFinalizer"
+
+// ScalingoAppFinalizerName holds the application resources until their Scalingo application is deleted.
+const ScalingoAppFinalizerName = "databases.scalingo.com/ScalingoAppFinalizer"
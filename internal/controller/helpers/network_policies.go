@@ -0,0 +1,163 @@
+package helpers
+
+import (
+	"context"
+	"net"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+const egressNetworkPolicyNameSuffix = "-egress"
+
+// IPResolver resolves a hostname to its IP addresses, as net.Resolver does.
+type IPResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// EgressPeer is a database endpoint destination: its CIDRs and port.
+type EgressPeer struct {
+	CIDRs []string
+	Port  int
+}
+
+// ComposeEgressPeers returns the destinations of every database endpoint.
+// The private peering endpoints use the database private IP range when known, the other endpoints use their
+// resolved addresses.
+func ComposeEgressPeers(ctx context.Context, resolver IPResolver, endpoints []domain.DatabaseEndpoint, privateIPRange string) ([]EgressPeer, error) {
+	peers := make([]EgressPeer, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		var cidrs []string
+		if endpoint.Type == domain.DatabaseEndpointTypePrivatePeeringRW && privateIPRange != "" {
+			cidrs = []string{privateIPRange}
+		} else if ip := net.ParseIP(endpoint.Hostname); ip != nil {
+			cidrs = []string{ipToCIDR(ip)}
+		} else {
+			addrs, err := resolver.LookupIPAddr(ctx, endpoint.Hostname)
+			if err != nil {
+				return nil, errors.Wrapf(ctx, err, "resolve endpoint %s", endpoint.Hostname)
+			}
+			for _, addr := range addrs {
+				cidrs = append(cidrs, ipToCIDR(addr.IP))
+			}
+		}
+		if len(cidrs) == 0 {
+			return nil, errors.Newf(ctx, "no address for endpoint %s", endpoint.Hostname)
+		}
+
+		slices.Sort(cidrs)
+		peers = append(peers, EgressPeer{
+			CIDRs: slices.Compact(cidrs),
+			Port:  endpoint.Port,
+		})
+	}
+	return peers, nil
+}
+
+func ipToCIDR(ip net.IP) string {
+	if ip.To4() != nil {
+		return ip.String() + "/32"
+	}
+	return ip.String() + "/128"
+}
+
+// NetworkPolicyManager manages the NetworkPolicy allowing egress to the database endpoints.
+type NetworkPolicyManager struct {
+	k8sClient          client.Client
+	databaseMetaObject metav1.Object
+}
+
+func NewNetworkPolicyManager(k8sClient client.Client, databaseMetaObject metav1.Object) *NetworkPolicyManager {
+	return &NetworkPolicyManager{
+		k8sClient:          k8sClient,
+		databaseMetaObject: databaseMetaObject,
+	}
+}
+
+// ComposeEgressNetworkPolicyName returns the NetworkPolicy name of a database, e.g. "my-db-egress".
+func ComposeEgressNetworkPolicyName(databaseName string) string {
+	return databaseName + egressNetworkPolicyNameSuffix
+}
+
+// SetEgressNetworkPolicy creates or updates the NetworkPolicy allowing egress from the selected pods to the peers.
+func (m NetworkPolicyManager) SetEgressNetworkPolicy(ctx context.Context, podSelector metav1.LabelSelector, peers []EgressPeer) error {
+	if len(peers) == 0 {
+		// An egress policy without rules denies all egress traffic of the selected pods.
+		return errors.New(ctx, "no egress peer")
+	}
+
+	egressRules := make([]networkingv1.NetworkPolicyEgressRule, 0, len(peers))
+	for _, peer := range peers {
+		to := make([]networkingv1.NetworkPolicyPeer, 0, len(peer.CIDRs))
+		for _, cidr := range peer.CIDRs {
+			to = append(to, networkingv1.NetworkPolicyPeer{
+				IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+			})
+		}
+		egressRules = append(egressRules, networkingv1.NetworkPolicyEgressRule{
+			To: to,
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: ptr.To(corev1.ProtocolTCP),
+				Port:     ptr.To(intstr.FromInt(peer.Port)),
+			}},
+		})
+	}
+
+	networkPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ComposeEgressNetworkPolicyName(m.databaseMetaObject.GetName()),
+			Namespace: m.databaseMetaObject.GetNamespace(),
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, m.k8sClient, networkPolicy, func() error {
+		networkPolicy.Spec = networkingv1.NetworkPolicySpec{
+			PodSelector: podSelector,
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress:      egressRules,
+		}
+
+		err := controllerutil.SetControllerReference(m.databaseMetaObject, networkPolicy, m.k8sClient.Scheme())
+		if err != nil {
+			return errors.Wrap(ctx, err, "set controller reference on network policy")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, "create or update network policy")
+	}
+	return nil
+}
+
+// DeleteEgressNetworkPolicy deletes the NetworkPolicy, if it exists and is owned by the database resource.
+func (m NetworkPolicyManager) DeleteEgressNetworkPolicy(ctx context.Context) error {
+	var networkPolicy networkingv1.NetworkPolicy
+	err := m.k8sClient.Get(ctx, client.ObjectKey{
+		Namespace: m.databaseMetaObject.GetNamespace(),
+		Name:      ComposeEgressNetworkPolicyName(m.databaseMetaObject.GetName()),
+	}, &networkPolicy)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(ctx, err, "get network policy")
+	}
+	if !metav1.IsControlledBy(&networkPolicy, m.databaseMetaObject) {
+		return nil
+	}
+
+	err = m.k8sClient.Delete(ctx, &networkPolicy)
+	if client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(ctx, err, "delete network policy")
+	}
+	return nil
+}
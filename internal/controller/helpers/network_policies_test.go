@@ -0,0 +1,168 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestComposeEgressPeers(t *testing.T) {
+	resolver := staticResolver{
+		"my-db.postgresql.example.test": {{IP: net.ParseIP("203.0.113.20")}, {IP: net.ParseIP("203.0.113.10")}, {IP: net.ParseIP("2001:db8::1")}},
+	}
+
+	t.Run("it uses the private IP range for the private peering endpoint", func(t *testing.T) {
+		peers, err := ComposeEgressPeers(t.Context(), resolver, []domain.DatabaseEndpoint{
+			{Hostname: "my-db.private.example.test", Port: 5432, Type: domain.DatabaseEndpointTypePrivatePeeringRW},
+		}, "10.231.23.0/24")
+
+		require.NoError(t, err)
+		require.Equal(t, []EgressPeer{{CIDRs: []string{"10.231.23.0/24"}, Port: 5432}}, peers)
+	})
+
+	t.Run("it resolves the public endpoint addresses", func(t *testing.T) {
+		peers, err := ComposeEgressPeers(t.Context(), resolver, []domain.DatabaseEndpoint{
+			{Hostname: "my-db.postgresql.example.test", Port: 30001, Type: domain.DatabaseEndpointTypePublicRW},
+			{Hostname: "198.51.100.7", Port: 30002, Type: domain.DatabaseEndpointTypePublicRW},
+		}, "10.231.23.0/24")
+
+		require.NoError(t, err)
+		require.Equal(t, []EgressPeer{
+			{CIDRs: []string{"2001:db8::1/128", "203.0.113.10/32", "203.0.113.20/32"}, Port: 30001},
+			{CIDRs: []string{"198.51.100.7/32"}, Port: 30002},
+		}, peers)
+	})
+
+	t.Run("it fails when the endpoint cannot be resolved", func(t *testing.T) {
+		_, err := ComposeEgressPeers(t.Context(), resolver, []domain.DatabaseEndpoint{
+			{Hostname: "unknown.example.test", Port: 30001, Type: domain.DatabaseEndpointTypePublicRW},
+		}, "")
+
+		require.ErrorContains(t, err, "resolve endpoint unknown.example.test")
+	})
+}
+
+func TestNetworkPolicyManager_SetEgressNetworkPolicy(t *testing.T) {
+	owner := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-db", Namespace: "default", UID: "owner-uid"}}
+
+	t.Run("it fails without peer", func(t *testing.T) {
+		manager := NewNetworkPolicyManager(nil, owner)
+
+		err := manager.SetEgressNetworkPolicy(t.Context(), metav1.LabelSelector{}, nil)
+
+		require.EqualError(t, err, "no egress peer")
+	})
+
+	t.Run("it creates the network policy", func(t *testing.T) {
+		k8sClient := newNetworkPolicyClient(t)
+		manager := NewNetworkPolicyManager(k8sClient, owner)
+		podSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+		err := manager.SetEgressNetworkPolicy(t.Context(), podSelector, []EgressPeer{
+			{CIDRs: []string{"10.231.23.0/24"}, Port: 5432},
+		})
+		require.NoError(t, err)
+
+		networkPolicy := k8sClient.networkPolicy
+		require.NotNil(t, networkPolicy)
+		require.Equal(t, "my-db-egress", networkPolicy.Name)
+		require.Equal(t, podSelector, networkPolicy.Spec.PodSelector)
+		require.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}, networkPolicy.Spec.PolicyTypes)
+		require.Len(t, networkPolicy.Spec.Egress, 1)
+		require.Equal(t, "10.231.23.0/24", networkPolicy.Spec.Egress[0].To[0].IPBlock.CIDR)
+		require.Equal(t, 5432, networkPolicy.Spec.Egress[0].Ports[0].Port.IntValue())
+		require.True(t, metav1.IsControlledBy(networkPolicy, owner))
+	})
+}
+
+func TestNetworkPolicyManager_DeleteEgressNetworkPolicy(t *testing.T) {
+	owner := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-db", Namespace: "default", UID: "owner-uid"}}
+
+	t.Run("it does nothing when the network policy does not exist", func(t *testing.T) {
+		manager := NewNetworkPolicyManager(newNetworkPolicyClient(t), owner)
+
+		require.NoError(t, manager.DeleteEgressNetworkPolicy(t.Context()))
+	})
+
+	t.Run("it keeps a network policy which is not owned", func(t *testing.T) {
+		k8sClient := newNetworkPolicyClient(t)
+		k8sClient.networkPolicy = &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "my-db-egress", Namespace: "default"}}
+		manager := NewNetworkPolicyManager(k8sClient, owner)
+
+		require.NoError(t, manager.DeleteEgressNetworkPolicy(t.Context()))
+		require.NotNil(t, k8sClient.networkPolicy)
+	})
+
+	t.Run("it deletes the owned network policy", func(t *testing.T) {
+		k8sClient := newNetworkPolicyClient(t)
+		manager := NewNetworkPolicyManager(k8sClient, owner)
+		err := manager.SetEgressNetworkPolicy(t.Context(), metav1.LabelSelector{}, []EgressPeer{{CIDRs: []string{"10.231.23.0/24"}, Port: 5432}})
+		require.NoError(t, err)
+
+		require.NoError(t, manager.DeleteEgressNetworkPolicy(t.Context()))
+		require.Nil(t, k8sClient.networkPolicy)
+	})
+}
+
+type staticResolver map[string][]net.IPAddr
+
+func (r staticResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func newNetworkPolicyClient(t *testing.T) *networkPolicyClient {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+	return &networkPolicyClient{scheme: scheme}
+}
+
+// networkPolicyClient is an in-memory client storing a single NetworkPolicy.
+type networkPolicyClient struct {
+	client.Client
+
+	scheme        *runtime.Scheme
+	networkPolicy *networkingv1.NetworkPolicy
+}
+
+func (c *networkPolicyClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if c.networkPolicy == nil {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "networkpolicies"}, key.Name)
+	}
+	c.networkPolicy.DeepCopyInto(obj.(*networkingv1.NetworkPolicy))
+	return nil
+}
+
+func (c *networkPolicyClient) Create(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+	return c.Update(ctx, obj)
+}
+
+func (c *networkPolicyClient) Update(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	c.networkPolicy = obj.(*networkingv1.NetworkPolicy).DeepCopy()
+	return nil
+}
+
+func (c *networkPolicyClient) Delete(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+	c.networkPolicy = nil
+	return nil
+}
+
+func (c *networkPolicyClient) Scheme() *runtime.Scheme {
+	return c.scheme
+}
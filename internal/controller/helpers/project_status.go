@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ProjectStatusConditionAvailable is the condition of the project resources whose project exists on Scalingo.
+	ProjectStatusConditionAvailable = "Available"
+	// ProjectStatusConditionPrivateNetworkReady is the condition of the project resources whose project has a
+	// private network.
+	ProjectStatusConditionPrivateNetworkReady = "PrivateNetworkReady"
+)
+
+// SetProjectAvailableStatus sets whether the project is available on Scalingo, and returns whether the
+// conditions changed.
+func SetProjectAvailableStatus(conditions *[]metav1.Condition, isAvailable bool, generation int64) bool {
+	condition := metav1.Condition{
+		Type:               ProjectStatusConditionAvailable,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reasonProjectAvailable,
+		Message:            msgProjectAvailable,
+	}
+	if !isAvailable {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonProjectNotAvailable
+		condition.Message = msgProjectNotAvailable
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// SetProjectPrivateNetworkReadyStatus sets whether the project has a private network, and returns whether the
+// conditions changed.
+func SetProjectPrivateNetworkReadyStatus(conditions *[]metav1.Condition, isReady bool, generation int64) bool {
+	condition := metav1.Condition{
+		Type:               ProjectStatusConditionPrivateNetworkReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reasonPrivateNetworkReady,
+		Message:            msgPrivateNetworkReady,
+	}
+	if !isReady {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonPrivateNetworkNotReady
+		condition.Message = msgPrivateNetworkNotReady
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// Private constants.
+const (
+	reasonProjectAvailable       = "ProjectAvailable"
+	reasonProjectNotAvailable    = "ProjectNotAvailable"
+	reasonPrivateNetworkReady    = "PrivateNetworkReady"
+	reasonPrivateNetworkNotReady = "PrivateNetworkNotReady"
+
+	msgProjectAvailable       = "The project is available on Scalingo."
+	msgProjectNotAvailable    = "The project is not yet available on Scalingo."
+	msgPrivateNetworkReady    = "The project private network is ready."
+	msgPrivateNetworkNotReady = "The project private network is created with its first private resource, e.g. a database with an IP range."
+)
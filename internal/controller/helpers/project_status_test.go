@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetProjectAvailableStatus(t *testing.T) {
+	t.Run("it sets the project available", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		isChanged := SetProjectAvailableStatus(&conditions, true, 2)
+
+		require.True(t, isChanged)
+		condition := meta.FindStatusCondition(conditions, ProjectStatusConditionAvailable)
+		require.NotNil(t, condition)
+		require.Equal(t, metav1.ConditionTrue, condition.Status)
+		require.Equal(t, reasonProjectAvailable, condition.Reason)
+		require.Equal(t, int64(2), condition.ObservedGeneration)
+	})
+
+	t.Run("it sets the project not available", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		SetProjectAvailableStatus(&conditions, false, 1)
+
+		condition := meta.FindStatusCondition(conditions, ProjectStatusConditionAvailable)
+		require.NotNil(t, condition)
+		require.Equal(t, metav1.ConditionFalse, condition.Status)
+		require.Equal(t, reasonProjectNotAvailable, condition.Reason)
+	})
+
+	t.Run("it does not change an up to date condition", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetProjectAvailableStatus(&conditions, true, 1)
+
+		isChanged := SetProjectAvailableStatus(&conditions, true, 1)
+
+		require.False(t, isChanged)
+	})
+}
+
+func TestSetProjectPrivateNetworkReadyStatus(t *testing.T) {
+	t.Run("it sets the private network ready", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		isChanged := SetProjectPrivateNetworkReadyStatus(&conditions, true, 2)
+
+		require.True(t, isChanged)
+		condition := meta.FindStatusCondition(conditions, ProjectStatusConditionPrivateNetworkReady)
+		require.NotNil(t, condition)
+		require.Equal(t, metav1.ConditionTrue, condition.Status)
+		require.Equal(t, reasonPrivateNetworkReady, condition.Reason)
+		require.Equal(t, int64(2), condition.ObservedGeneration)
+	})
+
+	t.Run("it sets the private network not ready", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		SetProjectPrivateNetworkReadyStatus(&conditions, false, 1)
+
+		condition := meta.FindStatusCondition(conditions, ProjectStatusConditionPrivateNetworkReady)
+		require.NotNil(t, condition)
+		require.Equal(t, metav1.ConditionFalse, condition.Status)
+		require.Equal(t, reasonPrivateNetworkNotReady, condition.Reason)
+	})
+
+	t.Run("it does not change an up to date condition", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetProjectPrivateNetworkReadyStatus(&conditions, false, 1)
+
+		isChanged := SetProjectPrivateNetworkReadyStatus(&conditions, false, 1)
+
+		require.False(t, isChanged)
+	})
+}
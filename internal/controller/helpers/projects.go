@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+)
+
+// ProjectResolver reads the Scalingo project ID of the ScalingoProject resource referenced by a database.
+type ProjectResolver struct {
+	client.Client
+}
+
+// ResolveProjectID returns the Scalingo project ID of the referenced ScalingoProject resource of the namespace.
+// It fails until the project is created on Scalingo.
+func (r ProjectResolver) ResolveProjectID(ctx context.Context, namespace string, ref apiv1.ProjectReference) (string, error) {
+	var project apiv1.ScalingoProject
+	err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, &project)
+	if err != nil {
+		return "", errors.Wrapf(ctx, err, "get scalingo project %s", ref.Name)
+	}
+
+	if project.Status.ScalingoProjectID == "" {
+		return "", errors.Newf(ctx, "scalingo project %s is not created yet", ref.Name)
+	}
+	return project.Status.ScalingoProjectID, nil
+}
+
+// UsesScalingoProject returns whether the database references the given ScalingoProject.
+func UsesScalingoProject(spec apiv1.DatabaseSpec, namespace string, project client.Object) bool {
+	return spec.ProjectRef != nil && namespace == project.GetNamespace() && spec.ProjectRef.Name == project.GetName()
+}
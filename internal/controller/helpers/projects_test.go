@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+)
+
+func TestProjectResolver_ResolveProjectID(t *testing.T) {
+	resolver := ProjectResolver{Client: &scalingoProjectClient{
+		projects: []apiv1.ScalingoProject{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "customer-a", Namespace: "default"},
+				Status:     apiv1.ScalingoProjectStatus{ScalingoProjectID: "prj-123"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "customer-b", Namespace: "default"},
+			},
+		},
+	}}
+
+	t.Run("it returns the Scalingo project ID", func(t *testing.T) {
+		projectID, err := resolver.ResolveProjectID(t.Context(), "default", apiv1.ProjectReference{Name: "customer-a"})
+
+		require.NoError(t, err)
+		require.Equal(t, "prj-123", projectID)
+	})
+
+	t.Run("it fails when the project is not created yet", func(t *testing.T) {
+		_, err := resolver.ResolveProjectID(t.Context(), "default", apiv1.ProjectReference{Name: "customer-b"})
+
+		require.EqualError(t, err, "scalingo project customer-b is not created yet")
+	})
+
+	t.Run("it does not read the projects of another namespace", func(t *testing.T) {
+		_, err := resolver.ResolveProjectID(t.Context(), "other", apiv1.ProjectReference{Name: "customer-a"})
+
+		require.ErrorContains(t, err, "get scalingo project customer-a")
+	})
+}
+
+func TestUsesScalingoProject(t *testing.T) {
+	project := &apiv1.ScalingoProject{ObjectMeta: metav1.ObjectMeta{Name: "customer-a", Namespace: "default"}}
+	spec := apiv1.DatabaseSpec{ProjectRef: &apiv1.ProjectReference{Name: "customer-a"}}
+
+	require.True(t, UsesScalingoProject(spec, "default", project))
+	require.False(t, UsesScalingoProject(spec, "other", project))
+	require.False(t, UsesScalingoProject(apiv1.DatabaseSpec{ProjectID: "prj-123"}, "default", project))
+}
+
+type scalingoProjectClient struct {
+	client.Client
+
+	projects []apiv1.ScalingoProject
+}
+
+func (c *scalingoProjectClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	for _, project := range c.projects {
+		if project.Name == key.Name && project.Namespace == key.Namespace {
+			project.DeepCopyInto(obj.(*apiv1.ScalingoProject))
+			return nil
+		}
+	}
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "scalingoprojects"}, key.Name)
+}
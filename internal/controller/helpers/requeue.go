@@ -5,4 +5,16 @@ import "time"
 const (
 	RequeueShortDelay = 1 * time.Second
 	RequeueLongDelay  = 30 * time.Second
+
+	// RequeueRefreshDelay is the delay between two refreshes of the resources derived from the database, e.g. the
+	// Services and NetworkPolicy following the database endpoints.
+	RequeueRefreshDelay = 5 * time.Minute
 )
+
+// MinRequeueDelay returns the shortest of the two requeue delays, a zero delay meaning no requeue.
+func MinRequeueDelay(delay, otherDelay time.Duration) time.Duration {
+	if delay == 0 || (otherDelay > 0 && otherDelay < delay) {
+		return otherDelay
+	}
+	return delay
+}
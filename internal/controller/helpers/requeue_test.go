@@ -0,0 +1,24 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinRequeueDelay(t *testing.T) {
+	t.Run("it returns the other delay without delay", func(t *testing.T) {
+		require.Equal(t, RequeueRefreshDelay, MinRequeueDelay(0, RequeueRefreshDelay))
+	})
+
+	t.Run("it keeps the delay without other delay", func(t *testing.T) {
+		require.Equal(t, RequeueLongDelay, MinRequeueDelay(RequeueLongDelay, 0))
+	})
+
+	t.Run("it returns the shortest delay", func(t *testing.T) {
+		require.Equal(t, RequeueLongDelay, MinRequeueDelay(RequeueRefreshDelay, RequeueLongDelay))
+		require.Equal(t, RequeueLongDelay, MinRequeueDelay(RequeueLongDelay, RequeueRefreshDelay))
+		require.Equal(t, time.Duration(0), MinRequeueDelay(0, 0))
+	})
+}
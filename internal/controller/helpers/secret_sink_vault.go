@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/vault"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+const defaultVaultMount = "secret"
+
+// VaultSecretSink writes secrets as keys of a single HashiCorp Vault KV v2 secret.
+type VaultSecretSink struct {
+	vaultClient vault.Client
+	mount       string
+	path        string
+}
+
+func NewVaultSecretSink(vaultClient vault.Client, mount, path string) *VaultSecretSink {
+	if mount == "" {
+		mount = defaultVaultMount
+	}
+	return &VaultSecretSink{
+		vaultClient: vaultClient,
+		mount:       mount,
+		path:        path,
+	}
+}
+
+func (s VaultSecretSink) SetSecrets(ctx context.Context, secrets []domain.Secret) error {
+	if s.path == "" {
+		return errors.New(ctx, "empty vault path")
+	}
+	for _, secret := range secrets {
+		if secret.Key == "" {
+			return errors.New(ctx, "empty key")
+		}
+		if secret.Value == "" {
+			return errors.New(ctx, "empty value")
+		}
+	}
+
+	// KV v2 writes replace the whole secret: merge all the keys in the current data and write them at once.
+	data, version, err := s.vaultClient.ReadKVSecret(ctx, s.mount, s.path)
+	if err != nil {
+		return errors.Wrap(ctx, err, "read vault secret")
+	}
+	isChanged := false
+	for _, secret := range secrets {
+		if data[secret.Key] == secret.Value {
+			continue
+		}
+		data[secret.Key] = secret.Value
+		isChanged = true
+	}
+	if !isChanged {
+		return nil // Avoid creating a new secret version.
+	}
+
+	err = s.vaultClient.WriteKVSecret(ctx, s.mount, s.path, data, version)
+	if err != nil {
+		return errors.Wrap(ctx, err, "write vault secret")
+	}
+	return nil
+}
+
+// DeleteSecrets deletes the Vault secret with all its versions.
+func (s VaultSecretSink) DeleteSecrets(ctx context.Context) error {
+	if s.path == "" {
+		return errors.New(ctx, "empty vault path")
+	}
+
+	err := s.vaultClient.DeleteKVSecret(ctx, s.mount, s.path)
+	if err != nil {
+		return errors.Wrap(ctx, err, "delete vault secret")
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/vault/vaultmock"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestVaultSecretSink_SetSecrets(t *testing.T) {
+	const (
+		vaultMount = "kv"
+		vaultPath  = "team/my-db"
+	)
+
+	t.Run("it fails when key is empty", func(t *testing.T) {
+		sink := NewVaultSecretSink(nil, vaultMount, vaultPath)
+
+		err := sink.SetSecrets(t.Context(), []domain.Secret{{Value: "postgres://host/db"}})
+
+		require.EqualError(t, err, "empty key")
+	})
+
+	t.Run("it fails when value is empty", func(t *testing.T) {
+		sink := NewVaultSecretSink(nil, vaultMount, vaultPath)
+
+		err := sink.SetSecrets(t.Context(), []domain.Secret{{Key: "PG_URL"}})
+
+		require.EqualError(t, err, "empty value")
+	})
+
+	t.Run("it merges the key in the current secret data", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		vaultClient := vaultmock.NewMockClient(ctrl)
+
+		sink := NewVaultSecretSink(vaultClient, vaultMount, vaultPath)
+
+		vaultClient.EXPECT().ReadKVSecret(ctx, vaultMount, vaultPath).Return(map[string]string{"OTHER": "value"}, 2, nil)
+		vaultClient.EXPECT().WriteKVSecret(ctx, vaultMount, vaultPath, map[string]string{
+			"OTHER":  "value",
+			"PG_URL": "postgres://host/db",
+		}, 2).Return(nil)
+
+		err := sink.SetSecrets(ctx, []domain.Secret{{Key: "PG_URL", Value: "postgres://host/db"}})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it writes all the keys in a single secret version", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		vaultClient := vaultmock.NewMockClient(ctrl)
+
+		sink := NewVaultSecretSink(vaultClient, vaultMount, vaultPath)
+
+		vaultClient.EXPECT().ReadKVSecret(ctx, vaultMount, vaultPath).Return(map[string]string{"PG_URL": "postgres://host/db"}, 5, nil)
+		vaultClient.EXPECT().WriteKVSecret(ctx, vaultMount, vaultPath, map[string]string{
+			"PG_URL":      "postgres://host/db",
+			"PG_HOST":     "host",
+			"PG_PASSWORD": "secret",
+		}, 5).Return(nil)
+
+		err := sink.SetSecrets(ctx, []domain.Secret{
+			{Key: "PG_URL", Value: "postgres://host/db"},
+			{Key: "PG_HOST", Value: "host"},
+			{Key: "PG_PASSWORD", Value: "secret"},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it does not write an unchanged value", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		vaultClient := vaultmock.NewMockClient(ctrl)
+
+		sink := NewVaultSecretSink(vaultClient, vaultMount, vaultPath)
+
+		vaultClient.EXPECT().ReadKVSecret(ctx, vaultMount, vaultPath).Return(map[string]string{"PG_URL": "postgres://host/db"}, 1, nil)
+
+		err := sink.SetSecrets(ctx, []domain.Secret{{Key: "PG_URL", Value: "postgres://host/db"}})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it uses the default mount", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		vaultClient := vaultmock.NewMockClient(ctrl)
+
+		sink := NewVaultSecretSink(vaultClient, "", vaultPath)
+
+		vaultClient.EXPECT().ReadKVSecret(ctx, defaultVaultMount, vaultPath).Return(nil, 0, errors.New("boom"))
+
+		err := sink.SetSecrets(ctx, []domain.Secret{{Key: "PG_URL", Value: "postgres://host/db"}})
+
+		require.ErrorContains(t, err, "read vault secret")
+	})
+}
+
+func TestVaultSecretSink_DeleteSecrets(t *testing.T) {
+	t.Run("it fails when path is empty", func(t *testing.T) {
+		sink := NewVaultSecretSink(nil, "kv", "")
+
+		err := sink.DeleteSecrets(t.Context())
+
+		require.EqualError(t, err, "empty vault path")
+	})
+
+	t.Run("it deletes the vault secret", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		vaultClient := vaultmock.NewMockClient(ctrl)
+
+		sink := NewVaultSecretSink(vaultClient, "kv", "team/my-db")
+
+		vaultClient.EXPECT().DeleteKVSecret(ctx, "kv", "team/my-db").Return(nil)
+
+		err := sink.DeleteSecrets(ctx)
+
+		require.NoError(t, err)
+	})
+}
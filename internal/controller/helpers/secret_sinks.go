@@ -0,0 +1,87 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	vaultbase "github.com/Scalingo/scalingo-operator/internal/boundaries/out/vault/base"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// SecretSink is a destination where the connection information secrets are written.
+type SecretSink interface {
+	SetSecrets(ctx context.Context, secrets []domain.Secret) error
+}
+
+// SecretSinks writes every secret in all its sinks.
+type SecretSinks []SecretSink
+
+func (s SecretSinks) SetSecrets(ctx context.Context, secrets []domain.Secret) error {
+	for _, sink := range s {
+		err := sink.SetSecrets(ctx, secrets)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewConnInfoSecretSinks returns the sinks defined by the connection information secret target.
+func NewConnInfoSecretSinks(ctx context.Context, secretManager *SecretManager, namespace string, target apiv1.SecretTargetSpec) (SecretSinks, error) {
+	sinks := make(SecretSinks, 0, 2)
+	if target.IsKubernetesSecretEnabled() {
+		sinks = append(sinks, secretManager)
+	}
+
+	if target.Vault != nil {
+		vaultSink, err := newConnInfoVaultSecretSink(ctx, secretManager, namespace, *target.Vault)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, vaultSink)
+	}
+
+	if len(sinks) == 0 {
+		return nil, errors.New(ctx, "no connection information secret target")
+	}
+	return sinks, nil
+}
+
+// DeleteConnInfoVaultSecret deletes the Vault secret of the connection information, if any.
+func DeleteConnInfoVaultSecret(ctx context.Context, secretManager *SecretManager, namespace string, target *apiv1.VaultSecretTargetSpec) error {
+	if target == nil {
+		return nil
+	}
+
+	vaultSink, err := newConnInfoVaultSecretSink(ctx, secretManager, namespace, *target)
+	if err != nil {
+		return err
+	}
+	return vaultSink.DeleteSecrets(ctx)
+}
+
+// IsSameVaultSecret returns whether both Vault targets point at the same secret.
+func IsSameVaultSecret(a, b *apiv1.VaultSecretTargetSpec) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Address == b.Address && a.Mount == b.Mount && a.Path == b.Path
+}
+
+func newConnInfoVaultSecretSink(ctx context.Context, secretManager *SecretManager, namespace string, target apiv1.VaultSecretTargetSpec) (*VaultSecretSink, error) {
+	vaultToken, err := secretManager.GetSecret(ctx, domain.Secret{
+		Namespace: namespace,
+		Name:      target.TokenSecret.Name,
+		Key:       target.TokenSecret.Key,
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "get vault token secret")
+	}
+
+	vaultClient, err := vaultbase.NewClient(ctx, target.Address, vaultToken)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "new vault client")
+	}
+	return NewVaultSecretSink(vaultClient, target.Mount, target.Path), nil
+}
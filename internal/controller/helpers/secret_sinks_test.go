@@ -0,0 +1,105 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+type secretSinkStub struct {
+	secrets []domain.Secret
+	err     error
+}
+
+func (s *secretSinkStub) SetSecrets(_ context.Context, secrets []domain.Secret) error {
+	s.secrets = append(s.secrets, secrets...)
+	return s.err
+}
+
+func TestSecretSinks_SetSecrets(t *testing.T) {
+	secret := domain.Secret{Namespace: "default", Name: "my-secret", Key: "PG_URL", Value: "postgres://host/db"}
+
+	t.Run("it writes the secret in every sink", func(t *testing.T) {
+		sinkA := &secretSinkStub{}
+		sinkB := &secretSinkStub{}
+
+		err := SecretSinks{sinkA, sinkB}.SetSecrets(t.Context(), []domain.Secret{secret})
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.Secret{secret}, sinkA.secrets)
+		require.Equal(t, []domain.Secret{secret}, sinkB.secrets)
+	})
+
+	t.Run("it stops on first sink error", func(t *testing.T) {
+		sinkA := &secretSinkStub{err: errors.New("boom")}
+		sinkB := &secretSinkStub{}
+
+		err := SecretSinks{sinkA, sinkB}.SetSecrets(t.Context(), []domain.Secret{secret})
+
+		require.EqualError(t, err, "boom")
+		require.Empty(t, sinkB.secrets)
+	})
+}
+
+func TestNewConnInfoSecretSinks(t *testing.T) {
+	t.Run("it returns the Kubernetes secret sink by default", func(t *testing.T) {
+		secretManager := NewSecretManager(nil, nil)
+
+		sinks, err := NewConnInfoSecretSinks(t.Context(), secretManager, "default", apiv1.SecretTargetSpec{Name: "my-secret"})
+
+		require.NoError(t, err)
+		require.Equal(t, SecretSinks{secretManager}, sinks)
+	})
+
+	t.Run("it fails without any secret target", func(t *testing.T) {
+		secretManager := NewSecretManager(nil, nil)
+
+		_, err := NewConnInfoSecretSinks(t.Context(), secretManager, "default", apiv1.SecretTargetSpec{
+			Name:                 "my-secret",
+			SkipKubernetesSecret: true,
+		})
+
+		require.EqualError(t, err, "no connection information secret target")
+	})
+}
+
+func TestDeleteConnInfoVaultSecret(t *testing.T) {
+	t.Run("it does nothing without vault target", func(t *testing.T) {
+		err := DeleteConnInfoVaultSecret(t.Context(), NewSecretManager(nil, nil), "default", nil)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestIsSameVaultSecret(t *testing.T) {
+	target := &apiv1.VaultSecretTargetSpec{
+		Address:     "https://vault.example.com:8200",
+		Mount:       "secret",
+		Path:        "databases/my-db",
+		TokenSecret: apiv1.AuthSecretSpec{Name: "vault", Key: "token"},
+	}
+
+	t.Run("it ignores the token secret", func(t *testing.T) {
+		other := target.DeepCopy()
+		other.TokenSecret.Name = "other-vault"
+
+		require.True(t, IsSameVaultSecret(target, other))
+	})
+
+	t.Run("it differs when the path changed", func(t *testing.T) {
+		other := target.DeepCopy()
+		other.Path = "databases/other-db"
+
+		require.False(t, IsSameVaultSecret(target, other))
+	})
+
+	t.Run("it differs when a target is removed", func(t *testing.T) {
+		require.False(t, IsSameVaultSecret(target, nil))
+		require.True(t, IsSameVaultSecret(nil, nil))
+	})
+}
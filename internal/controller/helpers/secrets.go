@@ -86,3 +86,13 @@ func (m SecretManager) SetSecret(ctx context.Context, secret domain.Secret) erro
 	}
 	return nil
 }
+
+func (m SecretManager) SetSecrets(ctx context.Context, secrets []domain.Secret) error {
+	for _, secret := range secrets {
+		err := m.SetSecret(ctx, secret)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "set secret %s", secret.Key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package helpers
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+const (
+	// EndpointServiceDatabaseLabel holds the name of the database resource owning an endpoint Service.
+	EndpointServiceDatabaseLabel = "databases.scalingo.com/database"
+
+	endpointServicePortName = "database"
+	endpointSliceManagedBy  = "scalingo-operator"
+)
+
+// ServiceManager manages the in-cluster Services pointing at the database endpoints.
+type ServiceManager struct {
+	k8sClient          client.Client
+	databaseMetaObject metav1.Object
+}
+
+func NewServiceManager(k8sClient client.Client, databaseMetaObject metav1.Object) *ServiceManager {
+	return &ServiceManager{
+		k8sClient:          k8sClient,
+		databaseMetaObject: databaseMetaObject,
+	}
+}
+
+// ComposeEndpointServiceName returns the Service name of a database endpoint, e.g. "my-db-public-rw".
+func ComposeEndpointServiceName(databaseName string, endpointType domain.DatabaseEndpointType) string {
+	return databaseName + "-" + strings.ToLower(string(endpointType))
+}
+
+// ComposeEndpointServiceNames returns the Service names of the database endpoints.
+func ComposeEndpointServiceNames(databaseName string, endpoints []domain.DatabaseEndpoint) []string {
+	var names []string
+	for _, endpoint := range endpoints {
+		names = append(names, ComposeEndpointServiceName(databaseName, endpoint.Type))
+	}
+	return names
+}
+
+// SetEndpointServices creates or updates a Service for every endpoint, and deletes the Services of the
+// endpoints which no longer exist.
+// A hostname endpoint gets an ExternalName Service, an IP address endpoint gets a headless Service with
+// an EndpointSlice.
+func (m ServiceManager) SetEndpointServices(ctx context.Context, endpoints []domain.DatabaseEndpoint) error {
+	expectedServiceNames := make(map[string]bool, len(endpoints))
+	for _, endpoint := range endpoints {
+		name := ComposeEndpointServiceName(m.databaseMetaObject.GetName(), endpoint.Type)
+		expectedServiceNames[name] = true
+
+		err := m.setEndpointService(ctx, name, endpoint)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "set endpoint service %s", name)
+		}
+	}
+
+	var services corev1.ServiceList
+	err := m.k8sClient.List(ctx, &services,
+		client.InNamespace(m.databaseMetaObject.GetNamespace()),
+		client.MatchingLabels{EndpointServiceDatabaseLabel: m.databaseMetaObject.GetName()},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, "list endpoint services")
+	}
+
+	for _, service := range services.Items {
+		if expectedServiceNames[service.Name] || !metav1.IsControlledBy(&service, m.databaseMetaObject) {
+			continue
+		}
+
+		err = m.k8sClient.Delete(ctx, &service)
+		if client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(ctx, err, "delete endpoint service %s", service.Name)
+		}
+	}
+	return nil
+}
+
+func (m ServiceManager) setEndpointService(ctx context.Context, name string, endpoint domain.DatabaseEndpoint) error {
+	endpointIP := net.ParseIP(endpoint.Hostname)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: m.databaseMetaObject.GetNamespace(),
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, m.k8sClient, service, func() error {
+		if service.Labels == nil {
+			service.Labels = map[string]string{}
+		}
+		service.Labels[EndpointServiceDatabaseLabel] = m.databaseMetaObject.GetName()
+
+		service.Spec.Ports = []corev1.ServicePort{{
+			Name:     endpointServicePortName,
+			Protocol: corev1.ProtocolTCP,
+			Port:     int32(endpoint.Port),
+		}}
+		if endpointIP == nil {
+			service.Spec.Type = corev1.ServiceTypeExternalName
+			service.Spec.ExternalName = endpoint.Hostname
+			service.Spec.ClusterIP = ""
+			service.Spec.ClusterIPs = nil
+		} else {
+			service.Spec.Type = corev1.ServiceTypeClusterIP
+			service.Spec.ExternalName = ""
+			service.Spec.ClusterIP = corev1.ClusterIPNone
+		}
+
+		err := controllerutil.SetControllerReference(m.databaseMetaObject, service, m.k8sClient.Scheme())
+		if err != nil {
+			return errors.Wrap(ctx, err, "set controller reference on service")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, "create or update service")
+	}
+
+	if endpointIP == nil {
+		err = m.k8sClient.Delete(ctx, &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: service.Namespace},
+		})
+		if client.IgnoreNotFound(err) != nil {
+			return errors.Wrap(ctx, err, "delete endpoint slice")
+		}
+		return nil
+	}
+
+	return m.setEndpointSlice(ctx, name, endpointIP, endpoint.Port)
+}
+
+func (m ServiceManager) setEndpointSlice(ctx context.Context, name string, endpointIP net.IP, port int) error {
+	addressType := discoveryv1.AddressTypeIPv4
+	if endpointIP.To4() == nil {
+		addressType = discoveryv1.AddressTypeIPv6
+	}
+
+	endpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: m.databaseMetaObject.GetNamespace(),
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, m.k8sClient, endpointSlice, func() error {
+		if endpointSlice.Labels == nil {
+			endpointSlice.Labels = map[string]string{}
+		}
+		endpointSlice.Labels[discoveryv1.LabelServiceName] = name
+		endpointSlice.Labels[discoveryv1.LabelManagedBy] = endpointSliceManagedBy
+		endpointSlice.Labels[EndpointServiceDatabaseLabel] = m.databaseMetaObject.GetName()
+
+		endpointSlice.AddressType = addressType
+		endpointSlice.Endpoints = []discoveryv1.Endpoint{{
+			Addresses:  []string{endpointIP.String()},
+			Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)},
+		}}
+		endpointSlice.Ports = []discoveryv1.EndpointPort{{
+			Name:     ptr.To(endpointServicePortName),
+			Protocol: ptr.To(corev1.ProtocolTCP),
+			Port:     ptr.To(int32(port)),
+		}}
+
+		err := controllerutil.SetControllerReference(m.databaseMetaObject, endpointSlice, m.k8sClient.Scheme())
+		if err != nil {
+			return errors.Wrap(ctx, err, "set controller reference on endpoint slice")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, "create or update endpoint slice")
+	}
+	return nil
+}
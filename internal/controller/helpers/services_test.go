@@ -0,0 +1,174 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestComposeEndpointServiceName(t *testing.T) {
+	require.Equal(t, "my-db-public-rw", ComposeEndpointServiceName("my-db", domain.DatabaseEndpointTypePublicRW))
+	require.Equal(t, "my-db-private-peering-rw", ComposeEndpointServiceName("my-db", domain.DatabaseEndpointTypePrivatePeeringRW))
+}
+
+func TestComposeEndpointServiceNames(t *testing.T) {
+	require.Nil(t, ComposeEndpointServiceNames("my-db", nil))
+	require.Equal(t, []string{"my-db-public-rw", "my-db-private-peering-rw"}, ComposeEndpointServiceNames("my-db", []domain.DatabaseEndpoint{
+		{Hostname: "public-host", Port: 5432, Type: domain.DatabaseEndpointTypePublicRW},
+		{Hostname: "10.0.0.1", Port: 5432, Type: domain.DatabaseEndpointTypePrivatePeeringRW},
+	}))
+}
+
+func TestServiceManager_SetEndpointServices(t *testing.T) {
+	newClient := func(t *testing.T) *serviceClient {
+		scheme := runtime.NewScheme()
+		require.NoError(t, corev1.AddToScheme(scheme))
+		require.NoError(t, discoveryv1.AddToScheme(scheme))
+
+		return &serviceClient{
+			scheme:         scheme,
+			services:       map[string]*corev1.Service{},
+			endpointSlices: map[string]*discoveryv1.EndpointSlice{},
+		}
+	}
+	owner := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-db", Namespace: "default", UID: "owner-uid"}}
+
+	t.Run("it creates an ExternalName service for a hostname endpoint", func(t *testing.T) {
+		k8sClient := newClient(t)
+		manager := NewServiceManager(k8sClient, owner)
+
+		err := manager.SetEndpointServices(t.Context(), []domain.DatabaseEndpoint{
+			{Hostname: "my-db.postgresql.example.test", Port: 30001, Type: domain.DatabaseEndpointTypePublicRW},
+		})
+		require.NoError(t, err)
+
+		service := k8sClient.services["my-db-public-rw"]
+		require.NotNil(t, service)
+		require.Equal(t, corev1.ServiceTypeExternalName, service.Spec.Type)
+		require.Equal(t, "my-db.postgresql.example.test", service.Spec.ExternalName)
+		require.Equal(t, int32(30001), service.Spec.Ports[0].Port)
+		require.Equal(t, "my-db", service.Labels[EndpointServiceDatabaseLabel])
+		require.True(t, metav1.IsControlledBy(service, owner))
+		require.Empty(t, k8sClient.endpointSlices)
+	})
+
+	t.Run("it creates a headless service and an endpoint slice for an IP address endpoint", func(t *testing.T) {
+		k8sClient := newClient(t)
+		manager := NewServiceManager(k8sClient, owner)
+
+		err := manager.SetEndpointServices(t.Context(), []domain.DatabaseEndpoint{
+			{Hostname: "10.0.0.12", Port: 5432, Type: domain.DatabaseEndpointTypePrivatePeeringRW},
+		})
+		require.NoError(t, err)
+
+		service := k8sClient.services["my-db-private-peering-rw"]
+		require.NotNil(t, service)
+		require.Equal(t, corev1.ServiceTypeClusterIP, service.Spec.Type)
+		require.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+
+		endpointSlice := k8sClient.endpointSlices["my-db-private-peering-rw"]
+		require.NotNil(t, endpointSlice)
+		require.Equal(t, discoveryv1.AddressTypeIPv4, endpointSlice.AddressType)
+		require.Equal(t, []string{"10.0.0.12"}, endpointSlice.Endpoints[0].Addresses)
+		require.Equal(t, int32(5432), *endpointSlice.Ports[0].Port)
+		require.Equal(t, "my-db-private-peering-rw", endpointSlice.Labels[discoveryv1.LabelServiceName])
+	})
+
+	t.Run("it deletes the services of endpoints which no longer exist", func(t *testing.T) {
+		k8sClient := newClient(t)
+		manager := NewServiceManager(k8sClient, owner)
+
+		err := manager.SetEndpointServices(t.Context(), []domain.DatabaseEndpoint{
+			{Hostname: "my-db.postgresql.example.test", Port: 30001, Type: domain.DatabaseEndpointTypePublicRW},
+		})
+		require.NoError(t, err)
+		k8sClient.services["not-owned"] = &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name: "not-owned", Namespace: "default", Labels: map[string]string{EndpointServiceDatabaseLabel: "my-db"},
+		}}
+
+		err = manager.SetEndpointServices(t.Context(), nil)
+		require.NoError(t, err)
+
+		require.NotContains(t, k8sClient.services, "my-db-public-rw")
+		require.Contains(t, k8sClient.services, "not-owned")
+	})
+}
+
+// serviceClient is an in-memory client storing Services and EndpointSlices by name.
+type serviceClient struct {
+	client.Client
+
+	scheme         *runtime.Scheme
+	services       map[string]*corev1.Service
+	endpointSlices map[string]*discoveryv1.EndpointSlice
+}
+
+func (c *serviceClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	switch o := obj.(type) {
+	case *corev1.Service:
+		service, ok := c.services[key.Name]
+		if !ok {
+			return apierrors.NewNotFound(schema.GroupResource{Resource: "services"}, key.Name)
+		}
+		service.DeepCopyInto(o)
+	case *discoveryv1.EndpointSlice:
+		endpointSlice, ok := c.endpointSlices[key.Name]
+		if !ok {
+			return apierrors.NewNotFound(schema.GroupResource{Resource: "endpointslices"}, key.Name)
+		}
+		endpointSlice.DeepCopyInto(o)
+	}
+	return nil
+}
+
+func (c *serviceClient) Create(ctx context.Context, obj client.Object, _ ...client.CreateOption) error {
+	return c.Update(ctx, obj)
+}
+
+func (c *serviceClient) Update(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	switch o := obj.(type) {
+	case *corev1.Service:
+		c.services[o.Name] = o.DeepCopy()
+	case *discoveryv1.EndpointSlice:
+		c.endpointSlices[o.Name] = o.DeepCopy()
+	}
+	return nil
+}
+
+func (c *serviceClient) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	switch o := obj.(type) {
+	case *corev1.Service:
+		if _, ok := c.services[o.Name]; !ok {
+			return apierrors.NewNotFound(schema.GroupResource{Resource: "services"}, o.Name)
+		}
+		delete(c.services, o.Name)
+	case *discoveryv1.EndpointSlice:
+		if _, ok := c.endpointSlices[o.Name]; !ok {
+			return apierrors.NewNotFound(schema.GroupResource{Resource: "endpointslices"}, o.Name)
+		}
+		delete(c.endpointSlices, o.Name)
+	}
+	return nil
+}
+
+func (c *serviceClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	services := list.(*corev1.ServiceList)
+	for _, service := range c.services {
+		services.Items = append(services.Items, *service.DeepCopy())
+	}
+	return nil
+}
+
+func (c *serviceClient) Scheme() *runtime.Scheme {
+	return c.scheme
+}
@@ -1,8 +1,13 @@
 package helpers
 
 import (
+	"fmt"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
 )
 
 // Databases status annotation.
@@ -23,13 +28,17 @@ func IsDatabaseAvailable(conditions []metav1.Condition) bool {
 	return meta.IsStatusConditionTrue(conditions, string(DatabaseStatusConditionAvailable))
 }
 
-func IsDatabaseRunning(dbMeta metav1.ObjectMeta) bool {
-	return metav1.HasAnnotation(dbMeta, DatabaseAnnotationIsRunning) &&
-		dbMeta.Annotations[DatabaseAnnotationIsRunning] == annotationValueTrue
+func HasDatabaseRunningAnnotation(dbMeta metav1.Object) bool {
+	_, ok := dbMeta.GetAnnotations()[DatabaseAnnotationIsRunning]
+	return ok
 }
 
-func IsDatabaseDeletionRequested(dbMeta metav1.ObjectMeta) bool {
-	return !dbMeta.DeletionTimestamp.IsZero()
+func IsDatabaseRunning(dbMeta metav1.Object) bool {
+	return dbMeta.GetAnnotations()[DatabaseAnnotationIsRunning] == annotationValueTrue
+}
+
+func IsDatabaseDeletionRequested(dbMeta metav1.Object) bool {
+	return !dbMeta.GetDeletionTimestamp().IsZero()
 }
 
 func SetDatabaseInitialStatus(conditions *[]metav1.Condition) {
@@ -72,12 +81,166 @@ func SetDatabaseStatusProvisioned(conditions *[]metav1.Condition) {
 	})
 }
 
-func SetDatabaseIsNotRunning(dbMeta *metav1.ObjectMeta) {
-	metav1.SetMetaDataAnnotation(dbMeta, DatabaseAnnotationIsRunning, annotationValueFalse)
+func SetDatabaseIsNotRunning(dbMeta metav1.Object) {
+	setDatabaseAnnotation(dbMeta, DatabaseAnnotationIsRunning, annotationValueFalse)
+}
+
+func SetDatabaseIsRunning(dbMeta metav1.Object) {
+	setDatabaseAnnotation(dbMeta, DatabaseAnnotationIsRunning, annotationValueTrue)
+}
+
+func setDatabaseAnnotation(dbMeta metav1.Object, key, value string) {
+	annotations := dbMeta.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[key] = value
+	dbMeta.SetAnnotations(annotations)
+}
+
+// IsPreferredEndpointApplied returns whether the main connection URL matches the preferred endpoint
+// of the given resource generation.
+func IsPreferredEndpointApplied(conditions []metav1.Condition, preferredEndpoint string, generation int64) bool {
+	condition := meta.FindStatusCondition(conditions, string(DatabaseStatusConditionPreferredEndpointAvailable))
+	if preferredEndpoint == "" {
+		return condition == nil
+	}
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.ObservedGeneration == generation
 }
 
-func SetDatabaseIsRunning(dbMeta *metav1.ObjectMeta) {
-	metav1.SetMetaDataAnnotation(dbMeta, DatabaseAnnotationIsRunning, annotationValueTrue)
+func SetPreferredEndpointStatus(conditions *[]metav1.Condition, preferredEndpoint string, isAvailable bool, generation int64) {
+	if preferredEndpoint == "" {
+		meta.RemoveStatusCondition(conditions, string(DatabaseStatusConditionPreferredEndpointAvailable))
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               string(DatabaseStatusConditionPreferredEndpointAvailable),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reasonPreferredEndpointAvailable,
+		Message:            fmt.Sprintf(msgPreferredEndpointAvailable, preferredEndpoint),
+	}
+	if !isAvailable {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonPreferredEndpointNotAvailable
+		condition.Message = fmt.Sprintf(msgPreferredEndpointNotAvailable, preferredEndpoint)
+	}
+	meta.SetStatusCondition(conditions, condition)
+}
+
+// SetFirewallManagedRangesStatus sets whether the managed range names of the firewall rules are resolved,
+// and returns whether the conditions changed. The condition is removed when no rule references a managed
+// range by name.
+func SetFirewallManagedRangesStatus(conditions *[]metav1.Condition, hasRangeNames bool, resolveErr error, generation int64) bool {
+	if !hasRangeNames {
+		return meta.RemoveStatusCondition(conditions, string(DatabaseStatusConditionFirewallManagedRangesResolved))
+	}
+
+	condition := metav1.Condition{
+		Type:               string(DatabaseStatusConditionFirewallManagedRangesResolved),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reasonFirewallManagedRangesResolved,
+		Message:            msgFirewallManagedRangesResolved,
+	}
+	if resolveErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonFirewallManagedRangeNotFound
+		condition.Message = fmt.Sprintf(msgFirewallManagedRangeNotFound, resolveErr)
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// SetNetPeeringReadyStatus sets whether the net peerings are active, and returns whether the conditions
+// changed. A failed reconciliation, e.g. a net peering deletion to retry, takes precedence over the net
+// peering states. The condition is removed when no net peering is enabled nor left.
+func SetNetPeeringReadyStatus(conditions *[]metav1.Condition, isEnabled bool, netPeerings []apiv1.NetPeeringStatus, reconcileErr error, generation int64) bool {
+	if !isEnabled && len(netPeerings) == 0 && reconcileErr == nil {
+		return meta.RemoveStatusCondition(conditions, string(DatabaseStatusConditionNetPeeringReady))
+	}
+
+	var pendingNames, failedNames []string
+	for _, netPeering := range netPeerings {
+		switch netPeering.State {
+		case apiv1.NetPeeringStateActive:
+		case apiv1.NetPeeringStateFailed:
+			failedNames = append(failedNames, netPeering.Name)
+		default:
+			pendingNames = append(pendingNames, netPeering.Name)
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               string(DatabaseStatusConditionNetPeeringReady),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+	}
+	switch {
+	case reconcileErr != nil:
+		condition.Reason = reasonNetPeeringError
+		condition.Message = fmt.Sprintf(msgNetPeeringError, reconcileErr)
+	case len(failedNames) > 0:
+		condition.Reason = reasonNetPeeringFailed
+		condition.Message = fmt.Sprintf(msgNetPeeringFailed, strings.Join(failedNames, ", "))
+	case len(pendingNames) > 0:
+		condition.Reason = reasonNetPeeringPending
+		condition.Message = fmt.Sprintf(msgNetPeeringPending, strings.Join(pendingNames, ", "))
+	case len(netPeerings) == 0:
+		condition.Reason = reasonNetPeeringPending
+		condition.Message = msgNetPeeringNotCreated
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reasonNetPeeringActive
+		condition.Message = msgNetPeeringActive
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// SetProjectAppliedStatus sets whether the database is moved to its expected project, and returns whether the
+// conditions changed. The condition is removed when no project is expected.
+func SetProjectAppliedStatus(conditions *[]metav1.Condition, expectedProjectID string, moveErr error, generation int64) bool {
+	if expectedProjectID == "" {
+		return meta.RemoveStatusCondition(conditions, string(DatabaseStatusConditionProjectApplied))
+	}
+
+	condition := metav1.Condition{
+		Type:               string(DatabaseStatusConditionProjectApplied),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reasonProjectApplied,
+		Message:            fmt.Sprintf(msgProjectApplied, expectedProjectID),
+	}
+	if moveErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonProjectMoveRejected
+		condition.Message = fmt.Sprintf(msgProjectMoveRejected, expectedProjectID, moveErr)
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// IsCACertificateNotAvailable returns whether the connection information was last written without the CA
+// certificate.
+func IsCACertificateNotAvailable(conditions []metav1.Condition) bool {
+	return meta.IsStatusConditionFalse(conditions, string(DatabaseStatusConditionCACertificateAvailable))
+}
+
+// SetCACertificateAvailableStatus sets whether the CA certificate and the `sslmode=verify-full` connection URLs
+// are written in the connection information, and returns whether the conditions changed.
+func SetCACertificateAvailableStatus(conditions *[]metav1.Condition, fetchErr error, generation int64) bool {
+	condition := metav1.Condition{
+		Type:               string(DatabaseStatusConditionCACertificateAvailable),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reasonCACertificateAvailable,
+		Message:            msgCACertificateAvailable,
+	}
+	if fetchErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonCACertificateNotAvailable
+		condition.Message = fmt.Sprintf(msgCACertificateNotAvailable, fetchErr)
+	}
+	return meta.SetStatusCondition(conditions, condition)
 }
 
 // Private constants.
@@ -88,12 +251,47 @@ const (
 	reasonProvisioning   = "DatabaseProvisioning"
 	reasonProvisioned    = "Databaseprovisioned"
 
+	reasonPreferredEndpointAvailable    = "PreferredEndpointAvailable"
+	reasonPreferredEndpointNotAvailable = "PreferredEndpointNotAvailable"
+
+	reasonFirewallManagedRangesResolved = "FirewallManagedRangesResolved"
+	reasonFirewallManagedRangeNotFound  = "FirewallManagedRangeNotFound"
+
+	reasonNetPeeringActive  = "NetPeeringActive"
+	reasonNetPeeringPending = "NetPeeringPending"
+	reasonNetPeeringFailed  = "NetPeeringFailed"
+	reasonNetPeeringError   = "NetPeeringError"
+
+	reasonProjectApplied      = "ProjectApplied"
+	reasonProjectMoveRejected = "ProjectMoveRejected"
+
+	reasonCACertificateAvailable    = "CACertificateAvailable"
+	reasonCACertificateNotAvailable = "CACertificateNotAvailable"
+
 	msgNotAvailable   = "The database is not yet available on Scalingo."
 	msgAvailable      = "The database is available on Scalingo."
 	msgNotProvisioned = "The database is not yet provisioned on Scalingo."
 	msgProvisioning   = "The database is being provisioned on Scalingo."
 	msgProvisioned    = "The database is provisioned on Scalingo."
 
+	msgPreferredEndpointAvailable    = "The main connection URL uses the %s endpoint."
+	msgPreferredEndpointNotAvailable = "The %s endpoint is not available yet, the main connection URL uses the default database URL."
+
+	msgFirewallManagedRangesResolved = "The firewall managed range names are resolved."
+	msgFirewallManagedRangeNotFound  = "The firewall rules are not applied: %v."
+
+	msgNetPeeringActive     = "The net peerings are active."
+	msgNetPeeringPending    = "Waiting for the net peerings to be active: %s."
+	msgNetPeeringNotCreated = "Waiting for the database to create the net peerings."
+	msgNetPeeringFailed     = "The net peerings are not active after the timeout: %s."
+	msgNetPeeringError      = "The net peerings are not reconciled: %v."
+
+	msgProjectApplied      = "The database is in the project %s."
+	msgProjectMoveRejected = "The database is not moved to the project %s: %v."
+
+	msgCACertificateAvailable    = "The CA certificate is written along with the connection information."
+	msgCACertificateNotAvailable = "The CA certificate is not available, only the plain connection URLs are written: %v."
+
 	annotationValueTrue  = "true"
 	annotationValueFalse = "false"
 )
@@ -7,11 +7,19 @@ type DatabaseStatusCondition string
 const (
 	DatabaseStatusConditionAvailable    DatabaseStatusCondition = "Available"
 	DatabaseStatusConditionProvisioning DatabaseStatusCondition = "Provisioning"
+
+	DatabaseStatusConditionPreferredEndpointAvailable    DatabaseStatusCondition = "PreferredEndpointAvailable"
+	DatabaseStatusConditionFirewallManagedRangesResolved DatabaseStatusCondition = "FirewallManagedRangesResolved"
+	DatabaseStatusConditionNetPeeringReady               DatabaseStatusCondition = "NetPeeringReady"
+	DatabaseStatusConditionProjectApplied                DatabaseStatusCondition = "ProjectApplied"
+	DatabaseStatusConditionCACertificateAvailable        DatabaseStatusCondition = "CACertificateAvailable"
 )
 
 func (c DatabaseStatusCondition) Validate() error {
 	switch c {
-	case DatabaseStatusConditionAvailable, DatabaseStatusConditionProvisioning:
+	case DatabaseStatusConditionAvailable, DatabaseStatusConditionProvisioning, DatabaseStatusConditionPreferredEndpointAvailable,
+		DatabaseStatusConditionFirewallManagedRangesResolved, DatabaseStatusConditionNetPeeringReady, DatabaseStatusConditionProjectApplied,
+		DatabaseStatusConditionCACertificateAvailable:
 		return nil
 	default:
 		return fmt.Errorf("invalid database status condition: %s", c)
@@ -10,6 +10,9 @@ func TestStatusCondition_Validate(t *testing.T) {
 	t.Run("it successfully validates status", func(t *testing.T) {
 		require.NoError(t, DatabaseStatusConditionAvailable.Validate())
 		require.NoError(t, DatabaseStatusConditionProvisioning.Validate())
+		require.NoError(t, DatabaseStatusConditionPreferredEndpointAvailable.Validate())
+		require.NoError(t, DatabaseStatusConditionProjectApplied.Validate())
+		require.NoError(t, DatabaseStatusConditionCACertificateAvailable.Validate())
 	})
 
 	t.Run("it returns error", func(t *testing.T) {
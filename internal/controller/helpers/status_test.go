@@ -1,10 +1,13 @@
 package helpers
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
 )
 
 func TestIsDatabaseInitialized(t *testing.T) {
@@ -95,7 +98,7 @@ func TestIsDatabaseRunning(t *testing.T) {
 				DatabaseAnnotationIsRunning: "true",
 			},
 		}
-		require.True(t, IsDatabaseRunning(dbMeta))
+		require.True(t, IsDatabaseRunning(&dbMeta))
 	})
 
 	t.Run("returns false when annotation exists but is false", func(t *testing.T) {
@@ -104,19 +107,35 @@ func TestIsDatabaseRunning(t *testing.T) {
 				DatabaseAnnotationIsRunning: "false",
 			},
 		}
-		require.False(t, IsDatabaseRunning(dbMeta))
+		require.False(t, IsDatabaseRunning(&dbMeta))
 	})
 
 	t.Run("returns false when annotation does not exist", func(t *testing.T) {
 		dbMeta := metav1.ObjectMeta{
 			Annotations: map[string]string{},
 		}
-		require.False(t, IsDatabaseRunning(dbMeta))
+		require.False(t, IsDatabaseRunning(&dbMeta))
+	})
+
+	t.Run("returns false when annotations is nil", func(t *testing.T) {
+		dbMeta := metav1.ObjectMeta{}
+		require.False(t, IsDatabaseRunning(&dbMeta))
+	})
+}
+
+func TestHasDatabaseRunningAnnotation(t *testing.T) {
+	t.Run("returns true when annotation exists", func(t *testing.T) {
+		dbMeta := metav1.ObjectMeta{
+			Annotations: map[string]string{
+				DatabaseAnnotationIsRunning: "false",
+			},
+		}
+		require.True(t, HasDatabaseRunningAnnotation(&dbMeta))
 	})
 
 	t.Run("returns false when annotations is nil", func(t *testing.T) {
 		dbMeta := metav1.ObjectMeta{}
-		require.False(t, IsDatabaseRunning(dbMeta))
+		require.False(t, HasDatabaseRunningAnnotation(&dbMeta))
 	})
 }
 
@@ -126,12 +145,12 @@ func TestIsDatabaseDeletionRequested(t *testing.T) {
 		dbMeta := metav1.ObjectMeta{
 			DeletionTimestamp: &now,
 		}
-		require.True(t, IsDatabaseDeletionRequested(dbMeta))
+		require.True(t, IsDatabaseDeletionRequested(&dbMeta))
 	})
 
 	t.Run("returns false when deletion timestamp is not set", func(t *testing.T) {
 		dbMeta := metav1.ObjectMeta{}
-		require.False(t, IsDatabaseDeletionRequested(dbMeta))
+		require.False(t, IsDatabaseDeletionRequested(&dbMeta))
 	})
 }
 
@@ -221,7 +240,7 @@ func TestSetDatabaseIsNotRunning(t *testing.T) {
 	t.Run("creates and sets isRunning annotation to false", func(t *testing.T) {
 		dbMeta := metav1.ObjectMeta{}
 		SetDatabaseIsNotRunning(&dbMeta)
-		require.False(t, IsDatabaseRunning(dbMeta))
+		require.False(t, IsDatabaseRunning(&dbMeta))
 	})
 
 	t.Run("modifies isRunning annotation to false", func(t *testing.T) {
@@ -230,10 +249,10 @@ func TestSetDatabaseIsNotRunning(t *testing.T) {
 				DatabaseAnnotationIsRunning: "true",
 			},
 		}
-		require.True(t, IsDatabaseRunning(dbMeta))
+		require.True(t, IsDatabaseRunning(&dbMeta))
 
 		SetDatabaseIsNotRunning(&dbMeta)
-		require.False(t, IsDatabaseRunning(dbMeta))
+		require.False(t, IsDatabaseRunning(&dbMeta))
 	})
 }
 
@@ -241,7 +260,7 @@ func TestSetDatabaseIsRunning(t *testing.T) {
 	t.Run("creates and sets isRunning annotation to true", func(t *testing.T) {
 		dbMeta := metav1.ObjectMeta{}
 		SetDatabaseIsRunning(&dbMeta)
-		require.True(t, IsDatabaseRunning(dbMeta))
+		require.True(t, IsDatabaseRunning(&dbMeta))
 	})
 
 	t.Run("modifies isRunning annotation to true", func(t *testing.T) {
@@ -250,9 +269,207 @@ func TestSetDatabaseIsRunning(t *testing.T) {
 				DatabaseAnnotationIsRunning: "false",
 			},
 		}
-		require.False(t, IsDatabaseRunning(dbMeta))
+		require.False(t, IsDatabaseRunning(&dbMeta))
 
 		SetDatabaseIsRunning(&dbMeta)
-		require.True(t, IsDatabaseRunning(dbMeta))
+		require.True(t, IsDatabaseRunning(&dbMeta))
+	})
+}
+
+func TestIsPreferredEndpointApplied(t *testing.T) {
+	const generation = 3
+
+	t.Run("returns true without preferred endpoint nor condition", func(t *testing.T) {
+		require.True(t, IsPreferredEndpointApplied(nil, "", generation))
+	})
+
+	t.Run("returns false when the preferred endpoint was removed", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetPreferredEndpointStatus(&conditions, "public-rw", true, generation)
+
+		require.False(t, IsPreferredEndpointApplied(conditions, "", generation))
+	})
+
+	t.Run("returns false when the condition does not exist", func(t *testing.T) {
+		require.False(t, IsPreferredEndpointApplied(nil, "private-peering-rw", generation))
+	})
+
+	t.Run("returns false when the preferred endpoint is not available", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetPreferredEndpointStatus(&conditions, "private-peering-rw", false, generation)
+
+		require.False(t, IsPreferredEndpointApplied(conditions, "private-peering-rw", generation))
+	})
+
+	t.Run("returns false when the condition was set for a previous generation", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetPreferredEndpointStatus(&conditions, "public-rw", true, generation-1)
+
+		require.False(t, IsPreferredEndpointApplied(conditions, "private-peering-rw", generation))
+	})
+
+	t.Run("returns true when the preferred endpoint is applied", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetPreferredEndpointStatus(&conditions, "private-peering-rw", true, generation)
+
+		require.True(t, IsPreferredEndpointApplied(conditions, "private-peering-rw", generation))
+	})
+}
+
+func TestSetPreferredEndpointStatus(t *testing.T) {
+	t.Run("sets the condition to false when the endpoint is not available", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetPreferredEndpointStatus(&conditions, "private-peering-rw", false, 1)
+
+		require.Len(t, conditions, 1)
+		require.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+		require.Equal(t, reasonPreferredEndpointNotAvailable, conditions[0].Reason)
+		require.Equal(t, int64(1), conditions[0].ObservedGeneration)
+	})
+
+	t.Run("removes the condition without preferred endpoint", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetPreferredEndpointStatus(&conditions, "private-peering-rw", true, 1)
+		SetPreferredEndpointStatus(&conditions, "", false, 2)
+
+		require.Empty(t, conditions)
+	})
+}
+
+func TestSetFirewallManagedRangesStatus(t *testing.T) {
+	t.Run("sets the condition to false when a range name does not exist", func(t *testing.T) {
+		var conditions []metav1.Condition
+		isChanged := SetFirewallManagedRangesStatus(&conditions, true, errors.New(`firewall managed range not found: "Unknown"`), 3)
+
+		require.True(t, isChanged)
+		require.Len(t, conditions, 1)
+		require.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+		require.Equal(t, reasonFirewallManagedRangeNotFound, conditions[0].Reason)
+		require.Contains(t, conditions[0].Message, `"Unknown"`)
+		require.Equal(t, int64(3), conditions[0].ObservedGeneration)
+	})
+
+	t.Run("does not change a resolved condition", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetFirewallManagedRangesStatus(&conditions, true, nil, 1)
+
+		require.False(t, SetFirewallManagedRangesStatus(&conditions, true, nil, 1))
+		require.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+	})
+
+	t.Run("removes the condition without range name", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetFirewallManagedRangesStatus(&conditions, true, nil, 1)
+
+		require.True(t, SetFirewallManagedRangesStatus(&conditions, false, nil, 2))
+		require.Empty(t, conditions)
+	})
+}
+
+func TestSetNetPeeringReadyStatus(t *testing.T) {
+	t.Run("sets the condition to true when every net peering is active", func(t *testing.T) {
+		var conditions []metav1.Condition
+		isChanged := SetNetPeeringReadyStatus(&conditions, true, []apiv1.NetPeeringStatus{
+			{Name: "oks", State: apiv1.NetPeeringStateActive},
+			{Name: "staging", State: apiv1.NetPeeringStateActive},
+		}, nil, 2)
+
+		require.True(t, isChanged)
+		require.Len(t, conditions, 1)
+		require.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+		require.Equal(t, reasonNetPeeringActive, conditions[0].Reason)
+		require.Equal(t, int64(2), conditions[0].ObservedGeneration)
+	})
+
+	t.Run("lists the pending and failed net peerings", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetNetPeeringReadyStatus(&conditions, true, []apiv1.NetPeeringStatus{
+			{Name: "oks", State: apiv1.NetPeeringStatePending},
+		}, nil, 1)
+		require.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+		require.Equal(t, reasonNetPeeringPending, conditions[0].Reason)
+		require.Contains(t, conditions[0].Message, "oks")
+
+		SetNetPeeringReadyStatus(&conditions, true, []apiv1.NetPeeringStatus{
+			{Name: "oks", State: apiv1.NetPeeringStateFailed},
+			{Name: "staging", State: apiv1.NetPeeringStatePending},
+		}, nil, 1)
+		require.Equal(t, reasonNetPeeringFailed, conditions[0].Reason)
+		require.Equal(t, "The net peerings are not active after the timeout: oks.", conditions[0].Message)
+	})
+
+	t.Run("reports the reconciliation error", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetNetPeeringReadyStatus(&conditions, false, nil, errors.New("delete net peering resource pcx-1234: boom"), 1)
+
+		require.Len(t, conditions, 1)
+		require.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+		require.Equal(t, reasonNetPeeringError, conditions[0].Reason)
+		require.Contains(t, conditions[0].Message, "boom")
+	})
+
+	t.Run("removes the condition without net peering", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetNetPeeringReadyStatus(&conditions, true, nil, nil, 1)
+
+		require.True(t, SetNetPeeringReadyStatus(&conditions, false, nil, nil, 2))
+		require.Empty(t, conditions)
+	})
+}
+
+func TestSetProjectAppliedStatus(t *testing.T) {
+	t.Run("sets the condition to true when the database is in the project", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		isChanged := SetProjectAppliedStatus(&conditions, "prj-123", nil, 2)
+
+		require.True(t, isChanged)
+		require.Len(t, conditions, 1)
+		require.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+		require.Equal(t, reasonProjectApplied, conditions[0].Reason)
+		require.Equal(t, int64(2), conditions[0].ObservedGeneration)
+	})
+
+	t.Run("reports the rejected move", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		SetProjectAppliedStatus(&conditions, "prj-123", errors.New("private network mismatch"), 1)
+
+		require.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+		require.Equal(t, reasonProjectMoveRejected, conditions[0].Reason)
+		require.Equal(t, "The database is not moved to the project prj-123: private network mismatch.", conditions[0].Message)
+	})
+
+	t.Run("removes the condition when no project is expected", func(t *testing.T) {
+		var conditions []metav1.Condition
+		SetProjectAppliedStatus(&conditions, "prj-123", nil, 1)
+
+		require.True(t, SetProjectAppliedStatus(&conditions, "", nil, 2))
+		require.Empty(t, conditions)
+	})
+}
+
+func TestSetCACertificateAvailableStatus(t *testing.T) {
+	t.Run("sets the condition to true when the CA certificate is written", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		isChanged := SetCACertificateAvailableStatus(&conditions, nil, 2)
+
+		require.True(t, isChanged)
+		require.Len(t, conditions, 1)
+		require.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+		require.Equal(t, reasonCACertificateAvailable, conditions[0].Reason)
+		require.False(t, IsCACertificateNotAvailable(conditions))
+	})
+
+	t.Run("reports the unavailable CA certificate", func(t *testing.T) {
+		var conditions []metav1.Condition
+
+		SetCACertificateAvailableStatus(&conditions, errors.New("unexpected status 503"), 1)
+
+		require.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+		require.Equal(t, reasonCACertificateNotAvailable, conditions[0].Reason)
+		require.Equal(t, "The CA certificate is not available, only the plain connection URLs are written: unexpected status 503.", conditions[0].Message)
+		require.True(t, IsCACertificateNotAvailable(conditions))
 	})
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/controller/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// MongoDBEngine is the database engine of the MongoDB resources.
+type MongoDBEngine struct{}
+
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=mongodbs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=mongodbs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=mongodbs/finalizers,verbs=update
+
+func (MongoDBEngine) DatabaseType() domain.DatabaseType {
+	return domain.DatabaseTypeMongoDB
+}
+
+func (MongoDBEngine) FinalizerName() string {
+	return helpers.MongoDBFinalizerName
+}
+
+func (MongoDBEngine) NewObject() DatabaseObject {
+	return &apiv1.MongoDB{}
+}
+
+func (MongoDBEngine) NewObjectList() client.ObjectList {
+	return &apiv1.MongoDBList{}
+}
+
+func (MongoDBEngine) ToDatabase(ctx context.Context, object DatabaseObject) (domain.Database, error) {
+	return adapters.MongoDBToDatabase(ctx, *object.(*apiv1.MongoDB))
+}
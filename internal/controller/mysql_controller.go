@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/controller/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// MySQLEngine is the database engine of the MySQL resources.
+type MySQLEngine struct{}
+
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=mysqls,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=mysqls/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=mysqls/finalizers,verbs=update
+
+func (MySQLEngine) DatabaseType() domain.DatabaseType {
+	return domain.DatabaseTypeMySQL
+}
+
+func (MySQLEngine) FinalizerName() string {
+	return helpers.MySQLFinalizerName
+}
+
+func (MySQLEngine) NewObject() DatabaseObject {
+	return &apiv1.MySQL{}
+}
+
+func (MySQLEngine) NewObjectList() client.ObjectList {
+	return &apiv1.MySQLList{}
+}
+
+func (MySQLEngine) ToDatabase(ctx context.Context, object DatabaseObject) (domain.Database, error) {
+	return adapters.MySQLToDatabase(ctx, *object.(*apiv1.MySQL))
+}
@@ -0,0 +1,142 @@
+package networking
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// ClusterEgressResolver resolves the IPs used by the cluster to reach the databases.
+type ClusterEgressResolver struct {
+	client.Client
+}
+
+// ResolveIPs returns the cluster egress IPs listed in the referenced ConfigMap, or the ExternalIP addresses
+// of the cluster nodes by default.
+func (r ClusterEgressResolver) ResolveIPs(ctx context.Context, namespace string, spec *apiv1.ClusterEgressSpec) ([]net.IP, error) {
+	var (
+		ips []net.IP
+		err error
+	)
+	if spec != nil && spec.IPsConfigMap != nil {
+		ips, err = r.configMapIPs(ctx, namespace, *spec.IPsConfigMap)
+	} else {
+		ips, err = r.nodeExternalIPs(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Without any IP, the cluster egress rules would be deleted and the cluster locked out of the database.
+	if len(ips) == 0 {
+		return nil, errors.New(ctx, "no cluster egress ip")
+	}
+	return ips, nil
+}
+
+func (r ClusterEgressResolver) configMapIPs(ctx context.Context, namespace string, ref apiv1.ConfigMapKeySpec) ([]net.IP, error) {
+	var configMap corev1.ConfigMap
+	err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, &configMap)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get config map %s", ref.Name)
+	}
+
+	value, ok := configMap.Data[ref.Key]
+	if !ok {
+		return nil, errors.Newf(ctx, "key %s not found in config map %s", ref.Key, ref.Name)
+	}
+
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\t'
+	})
+	ips := make([]net.IP, 0, len(fields))
+	for _, field := range fields {
+		ip := net.ParseIP(field)
+		if ip == nil {
+			return nil, errors.Newf(ctx, "invalid cluster egress ip %q in config map %s", field, ref.Name)
+		}
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
+func (r ClusterEgressResolver) nodeExternalIPs(ctx context.Context) ([]net.IP, error) {
+	var nodes corev1.NodeList
+	err := r.List(ctx, &nodes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "list nodes")
+	}
+
+	var ips []net.IP
+	for _, node := range nodes.Items {
+		for _, address := range nodeExternalIPs(node) {
+			ip := net.ParseIP(address)
+			if ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips, nil
+}
+
+func nodeExternalIPs(node corev1.Node) []string {
+	var addresses []string
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeExternalIP {
+			addresses = append(addresses, address.Address)
+		}
+	}
+	return addresses
+}
+
+// UsesClusterEgress returns whether the networking, or the rules of its FirewallRuleSet resources, define
+// cluster egress firewall rules.
+func UsesClusterEgress(spec apiv1.NetworkingSpec, ruleSetRules []apiv1.FirewallRuleSpec) bool {
+	if spec.Firewall == nil {
+		return false
+	}
+	isClusterEgress := func(rule apiv1.FirewallRuleSpec) bool {
+		return domain.FirewallRuleType(rule.Type) == domain.FirewallRuleTypeClusterEgress
+	}
+	return slices.ContainsFunc(spec.Firewall.Rules, isClusterEgress) || slices.ContainsFunc(ruleSetRules, isClusterEgress)
+}
+
+// UsesClusterEgressConfigMap returns whether the cluster egress IPs are read from the given ConfigMap.
+func UsesClusterEgressConfigMap(spec apiv1.NetworkingSpec, ruleSetRules []apiv1.FirewallRuleSpec, namespace string, configMap client.Object) bool {
+	if !UsesClusterEgress(spec, ruleSetRules) || spec.Firewall.ClusterEgress == nil || spec.Firewall.ClusterEgress.IPsConfigMap == nil {
+		return false
+	}
+	return namespace == configMap.GetNamespace() && spec.Firewall.ClusterEgress.IPsConfigMap.Name == configMap.GetName()
+}
+
+// UsesClusterEgressNodes returns whether the cluster egress IPs are the nodes ExternalIP addresses.
+func UsesClusterEgressNodes(spec apiv1.NetworkingSpec, ruleSetRules []apiv1.FirewallRuleSpec) bool {
+	return UsesClusterEgress(spec, ruleSetRules) && (spec.Firewall.ClusterEgress == nil || spec.Firewall.ClusterEgress.IPsConfigMap == nil)
+}
+
+// NodeExternalIPsChangedPredicate filters the node events which may change the cluster egress IPs.
+func NodeExternalIPsChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, isOldNode := e.ObjectOld.(*corev1.Node)
+			newNode, isNewNode := e.ObjectNew.(*corev1.Node)
+			if !isOldNode || !isNewNode {
+				return false
+			}
+			return !slices.Equal(nodeExternalIPs(*oldNode), nodeExternalIPs(*newNode))
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
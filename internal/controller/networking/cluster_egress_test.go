@@ -0,0 +1,176 @@
+package networking
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+)
+
+func TestClusterEgressResolver_ResolveIPs(t *testing.T) {
+	t.Run("it returns the nodes external IPs by default", func(t *testing.T) {
+		resolver := ClusterEgressResolver{Client: &clusterEgressClient{
+			nodes: []corev1.Node{
+				newNode("node-1", "10.0.0.1", "203.0.113.1"),
+				newNode("node-2", "10.0.0.2", "203.0.113.2"),
+			},
+		}}
+
+		ips, err := resolver.ResolveIPs(t.Context(), "default", nil)
+
+		require.NoError(t, err)
+		require.Equal(t, []net.IP{net.ParseIP("203.0.113.1"), net.ParseIP("203.0.113.2")}, ips)
+	})
+
+	t.Run("it fails without any node external IP", func(t *testing.T) {
+		resolver := ClusterEgressResolver{Client: &clusterEgressClient{
+			nodes: []corev1.Node{newNode("node-1", "10.0.0.1", "")},
+		}}
+
+		_, err := resolver.ResolveIPs(t.Context(), "default", nil)
+
+		require.EqualError(t, err, "no cluster egress ip")
+	})
+
+	t.Run("it returns the IPs listed in the config map", func(t *testing.T) {
+		resolver := ClusterEgressResolver{Client: &clusterEgressClient{
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "egress", Namespace: "default"},
+				Data:       map[string]string{"ips": "198.51.100.1, 198.51.100.2\n2001:db8::1\n"},
+			},
+		}}
+
+		ips, err := resolver.ResolveIPs(t.Context(), "default", &apiv1.ClusterEgressSpec{
+			IPsConfigMap: &apiv1.ConfigMapKeySpec{Name: "egress", Key: "ips"},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []net.IP{net.ParseIP("198.51.100.1"), net.ParseIP("198.51.100.2"), net.ParseIP("2001:db8::1")}, ips)
+	})
+
+	t.Run("it fails with an invalid IP in the config map", func(t *testing.T) {
+		resolver := ClusterEgressResolver{Client: &clusterEgressClient{
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "egress", Namespace: "default"},
+				Data:       map[string]string{"ips": "198.51.100.1,not-an-ip"},
+			},
+		}}
+
+		_, err := resolver.ResolveIPs(t.Context(), "default", &apiv1.ClusterEgressSpec{
+			IPsConfigMap: &apiv1.ConfigMapKeySpec{Name: "egress", Key: "ips"},
+		})
+
+		require.EqualError(t, err, `invalid cluster egress ip "not-an-ip" in config map egress`)
+	})
+
+	t.Run("it fails when the config map key does not exist", func(t *testing.T) {
+		resolver := ClusterEgressResolver{Client: &clusterEgressClient{
+			configMap: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "egress", Namespace: "default"}},
+		}}
+
+		_, err := resolver.ResolveIPs(t.Context(), "default", &apiv1.ClusterEgressSpec{
+			IPsConfigMap: &apiv1.ConfigMapKeySpec{Name: "egress", Key: "ips"},
+		})
+
+		require.EqualError(t, err, "key ips not found in config map egress")
+	})
+}
+
+func TestUsesClusterEgress(t *testing.T) {
+	clusterEgressRules := []apiv1.FirewallRuleSpec{{Type: "cluster_egress"}}
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "egress", Namespace: "default"}}
+
+	t.Run("it does not use cluster egress without such rule", func(t *testing.T) {
+		spec := apiv1.NetworkingSpec{Firewall: &apiv1.FirewallSpec{Rules: []apiv1.FirewallRuleSpec{{Type: "custom_range"}}}}
+
+		require.False(t, UsesClusterEgress(spec, nil))
+		require.False(t, UsesClusterEgressNodes(spec, nil))
+		require.False(t, UsesClusterEgress(apiv1.NetworkingSpec{}, nil))
+	})
+
+	t.Run("it uses the nodes by default", func(t *testing.T) {
+		spec := apiv1.NetworkingSpec{Firewall: &apiv1.FirewallSpec{Rules: clusterEgressRules}}
+
+		require.True(t, UsesClusterEgressNodes(spec, nil))
+		require.False(t, UsesClusterEgressConfigMap(spec, nil, "default", configMap))
+	})
+
+	t.Run("it uses the referenced config map of the same namespace", func(t *testing.T) {
+		spec := apiv1.NetworkingSpec{Firewall: &apiv1.FirewallSpec{
+			Rules:         clusterEgressRules,
+			ClusterEgress: &apiv1.ClusterEgressSpec{IPsConfigMap: &apiv1.ConfigMapKeySpec{Name: "egress", Key: "ips"}},
+		}}
+
+		require.False(t, UsesClusterEgressNodes(spec, nil))
+		require.True(t, UsesClusterEgressConfigMap(spec, nil, "default", configMap))
+		require.False(t, UsesClusterEgressConfigMap(spec, nil, "other", configMap))
+	})
+
+	t.Run("it uses the cluster egress rules of the firewall rule sets", func(t *testing.T) {
+		spec := apiv1.NetworkingSpec{Firewall: &apiv1.FirewallSpec{
+			RuleSets:      []string{"egress"},
+			ClusterEgress: &apiv1.ClusterEgressSpec{IPsConfigMap: &apiv1.ConfigMapKeySpec{Name: "egress", Key: "ips"}},
+		}}
+
+		require.True(t, UsesClusterEgress(spec, clusterEgressRules))
+		require.True(t, UsesClusterEgressConfigMap(spec, clusterEgressRules, "default", configMap))
+		require.False(t, UsesClusterEgressConfigMap(spec, nil, "default", configMap))
+	})
+}
+
+func TestNodeExternalIPsChangedPredicate(t *testing.T) {
+	nodePredicate := NodeExternalIPsChangedPredicate()
+
+	require.True(t, nodePredicate.Create(event.CreateEvent{Object: ptrNode(newNode("node-1", "10.0.0.1", "203.0.113.1"))}))
+	require.True(t, nodePredicate.Delete(event.DeleteEvent{Object: ptrNode(newNode("node-1", "10.0.0.1", "203.0.113.1"))}))
+	require.False(t, nodePredicate.Update(event.UpdateEvent{
+		ObjectOld: ptrNode(newNode("node-1", "10.0.0.1", "203.0.113.1")),
+		ObjectNew: ptrNode(newNode("node-1", "10.0.0.9", "203.0.113.1")),
+	}))
+	require.True(t, nodePredicate.Update(event.UpdateEvent{
+		ObjectOld: ptrNode(newNode("node-1", "10.0.0.1", "203.0.113.1")),
+		ObjectNew: ptrNode(newNode("node-1", "10.0.0.1", "203.0.113.9")),
+	}))
+}
+
+func newNode(name, internalIP, externalIP string) corev1.Node {
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	node.Status.Addresses = append(node.Status.Addresses, corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: internalIP})
+	if externalIP != "" {
+		node.Status.Addresses = append(node.Status.Addresses, corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: externalIP})
+	}
+	return node
+}
+
+func ptrNode(node corev1.Node) *corev1.Node {
+	return &node
+}
+
+type clusterEgressClient struct {
+	client.Client
+
+	nodes     []corev1.Node
+	configMap *corev1.ConfigMap
+}
+
+func (c *clusterEgressClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if c.configMap == nil || c.configMap.Name != key.Name || c.configMap.Namespace != key.Namespace {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+	}
+	c.configMap.DeepCopyInto(obj.(*corev1.ConfigMap))
+	return nil
+}
+
+func (c *clusterEgressClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	list.(*corev1.NodeList).Items = c.nodes
+	return nil
+}
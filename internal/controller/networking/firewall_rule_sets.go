@@ -0,0 +1,38 @@
+package networking
+
+import (
+	"context"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+)
+
+// FirewallRuleSetResolver reads the rules of the FirewallRuleSet resources referenced by a database.
+type FirewallRuleSetResolver struct {
+	client.Client
+}
+
+// ResolveRules returns the rules of all the named FirewallRuleSet resources of the namespace.
+func (r FirewallRuleSetResolver) ResolveRules(ctx context.Context, namespace string, names []string) ([]apiv1.FirewallRuleSpec, error) {
+	var rules []apiv1.FirewallRuleSpec
+	for _, name := range names {
+		var ruleSet apiv1.FirewallRuleSet
+		err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &ruleSet)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "get firewall rule set %s", name)
+		}
+		rules = append(rules, ruleSet.Spec.Rules...)
+	}
+	return rules, nil
+}
+
+// UsesFirewallRuleSet returns whether the networking references the given FirewallRuleSet.
+func UsesFirewallRuleSet(spec apiv1.NetworkingSpec, namespace string, ruleSet client.Object) bool {
+	if spec.Firewall == nil || namespace != ruleSet.GetNamespace() {
+		return false
+	}
+	return slices.Contains(spec.Firewall.RuleSets, ruleSet.GetName())
+}
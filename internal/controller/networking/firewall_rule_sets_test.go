@@ -0,0 +1,80 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+)
+
+func TestFirewallRuleSetResolver_ResolveRules(t *testing.T) {
+	resolver := FirewallRuleSetResolver{Client: &firewallRuleSetClient{
+		ruleSets: []apiv1.FirewallRuleSet{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "offices", Namespace: "default"},
+				Spec: apiv1.FirewallRuleSetSpec{Rules: []apiv1.FirewallRuleSpec{
+					{Type: "custom_range", CIDR: "198.51.100.0/24", Label: "Paris office"},
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "vpn", Namespace: "default"},
+				Spec: apiv1.FirewallRuleSetSpec{Rules: []apiv1.FirewallRuleSpec{
+					{Type: "custom_range", CIDR: "203.0.113.1/32", Label: "VPN"},
+				}},
+			},
+		},
+	}}
+
+	t.Run("it merges the rules of the referenced sets", func(t *testing.T) {
+		rules, err := resolver.ResolveRules(t.Context(), "default", []string{"offices", "vpn"})
+
+		require.NoError(t, err)
+		require.Equal(t, []apiv1.FirewallRuleSpec{
+			{Type: "custom_range", CIDR: "198.51.100.0/24", Label: "Paris office"},
+			{Type: "custom_range", CIDR: "203.0.113.1/32", Label: "VPN"},
+		}, rules)
+	})
+
+	t.Run("it fails when a set does not exist", func(t *testing.T) {
+		_, err := resolver.ResolveRules(t.Context(), "default", []string{"offices", "unknown"})
+
+		require.ErrorContains(t, err, "get firewall rule set unknown")
+	})
+
+	t.Run("it does not read the sets of another namespace", func(t *testing.T) {
+		_, err := resolver.ResolveRules(t.Context(), "other", []string{"offices"})
+
+		require.ErrorContains(t, err, "get firewall rule set offices")
+	})
+}
+
+func TestUsesFirewallRuleSet(t *testing.T) {
+	ruleSet := &apiv1.FirewallRuleSet{ObjectMeta: metav1.ObjectMeta{Name: "offices", Namespace: "default"}}
+	spec := apiv1.NetworkingSpec{Firewall: &apiv1.FirewallSpec{RuleSets: []string{"offices"}}}
+
+	require.True(t, UsesFirewallRuleSet(spec, "default", ruleSet))
+	require.False(t, UsesFirewallRuleSet(spec, "other", ruleSet))
+	require.False(t, UsesFirewallRuleSet(apiv1.NetworkingSpec{}, "default", ruleSet))
+}
+
+type firewallRuleSetClient struct {
+	client.Client
+
+	ruleSets []apiv1.FirewallRuleSet
+}
+
+func (c *firewallRuleSetClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	for _, ruleSet := range c.ruleSets {
+		if ruleSet.Name == key.Name && ruleSet.Namespace == key.Namespace {
+			ruleSet.DeepCopyInto(obj.(*apiv1.FirewallRuleSet))
+			return nil
+		}
+	}
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "firewallrulesets"}, key.Name)
+}
@@ -2,8 +2,11 @@ package networking
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -11,6 +14,7 @@ import (
 	"github.com/Scalingo/go-utils/errors/v3"
 	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
 	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
 	databaseusecases "github.com/Scalingo/scalingo-operator/internal/usecases/database"
 )
 
@@ -18,14 +22,23 @@ type NetPeeringReconciler struct {
 	client.Client
 
 	Scheme *runtime.Scheme
+
+	// NewOutscaleClient creates the Outscale API client of the net peering, outscalebase.NewClient by default.
+	NewOutscaleClient NewOutscaleClientFunc
 }
 
+// NetPeeringTimeout is the delay after which a net peering still not active is failed.
+const NetPeeringTimeout = 30 * time.Minute
+
 type DatabaseResource struct {
 	Name       string
 	Namespace  string
 	Owner      client.Object
 	DatabaseID string
 	Networking apiv1.NetworkingSpec
+
+	// NetPeerings are the net peerings tracked in the resource status.
+	NetPeerings []apiv1.NetPeeringStatus
 }
 
 type DatabaseState struct {
@@ -34,107 +47,327 @@ type DatabaseState struct {
 	Provisioning      bool
 }
 
-func (r NetPeeringReconciler) Reconcile(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource, state DatabaseState) (time.Duration, error) {
+// Reconcile ensures the net peerings of the database, and returns the net peerings to track in the resource
+// status. The net peerings which are no longer expected are deleted on both sides.
+func (r NetPeeringReconciler) Reconcile(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource, state DatabaseState) ([]apiv1.NetPeeringStatus, time.Duration, error) {
 	if state.DeletionRequested {
-		return 0, nil
+		return resource.NetPeerings, 0, nil
 	}
 
 	log := logf.FromContext(ctx)
-	if !resource.Networking.IsOutscaleOKSNetPeeringEnabled() {
+	if !resource.Networking.IsOutscaleNetPeeringEnabled() {
 		err := r.DeleteNetPeerings(ctx, dbManager, resource)
 		if err != nil {
-			return 0, errors.Wrap(ctx, err, "delete net peering resources")
+			return resource.NetPeerings, 0, errors.Wrap(ctx, err, "delete net peering resources")
 		}
-		return 0, nil
+		return nil, 0, nil
 	}
 
 	if !state.Available || state.Provisioning || resource.DatabaseID == "" {
-		return 0, nil
+		return resource.NetPeerings, 0, nil
 	}
 
-	log.Info("Reconcile Outscale OKS net peering")
-	netPeeringID, err := r.ensureOKSNetPeeringID(ctx, dbManager, resource)
-	if err != nil {
-		return 0, errors.Wrap(ctx, err, "reconcile net peering request")
+	var netPeerings []apiv1.NetPeeringStatus
+	now := time.Now()
+	if resource.Networking.IsOutscaleOKSNetPeeringEnabled() {
+		netPeeringRequests, err := r.listExistingNetPeeringRequestsForDatabase(ctx, resource)
+		if err != nil {
+			return resource.NetPeerings, 0, errors.Wrap(ctx, err, "list existing net peering requests for database")
+		}
+		oksNetPeerings, err := r.listExistingNetPeeringsForDatabase(ctx, dbManager, resource)
+		if err != nil {
+			return resource.NetPeerings, 0, errors.Wrap(ctx, err, "list existing net peerings for database")
+		}
+
+		for _, name := range resource.Networking.OutscaleOKSNetPeeringNames() {
+			log.Info("Reconcile Outscale OKS net peering", "name", name)
+			netPeering, err := r.ensureOKSNetPeering(ctx, dbManager, resource, name, netPeeringRequests, oksNetPeerings)
+			if err != nil {
+				return resource.NetPeerings, 0, errors.Wrapf(ctx, err, "reconcile net peering request %s", name)
+			}
+
+			isActive := false
+			if netPeering.OutscaleNetPeeringID == "" {
+				log.Info("Waiting for NetPeeringRequest to be provisioned", "netPeeringRequest", netPeering.RequestName)
+			} else {
+				databaseNetPeering, err := dbManager.EnsureDatabaseNetPeering(ctx, resource.DatabaseID, netPeering.OutscaleNetPeeringID)
+				if err != nil {
+					return resource.NetPeerings, 0, errors.Wrapf(ctx, err, "ensure database net peering id %s", resource.DatabaseID)
+				}
+				netPeering.ScalingoNetPeeringID = databaseNetPeering.ID
+				isActive = databaseNetPeering.IsActive()
+			}
+			setNetPeeringState(&netPeering, resource.NetPeerings, isActive, now)
+			netPeerings = append(netPeerings, netPeering)
+		}
+	}
+
+	for _, spec := range resource.Networking.OutscaleAPINetPeerings() {
+		log.Info("Reconcile Outscale API net peering", "name", spec.Name)
+		outscaleNetPeering, isCreated, err := r.ensureOutscaleAPINetPeering(ctx, dbManager, resource, spec)
+		if err != nil {
+			return resource.NetPeerings, 0, errors.Wrapf(ctx, err, "reconcile outscale net peering %s", spec.Name)
+		}
+
+		databaseNetPeering, err := dbManager.EnsureDatabaseNetPeering(ctx, resource.DatabaseID, outscaleNetPeering.ID)
+		if err != nil {
+			return resource.NetPeerings, 0, errors.Wrapf(ctx, err, "ensure database net peering id %s", resource.DatabaseID)
+		}
+
+		netPeering := apiv1.NetPeeringStatus{
+			Name:                 spec.Name,
+			OutscaleNetPeeringID: outscaleNetPeering.ID,
+			ScalingoNetPeeringID: databaseNetPeering.ID,
+		}
+		if isCreated || isOutscaleAPINetPeeringCreated(resource.NetPeerings, spec.Name, outscaleNetPeering.ID) {
+			netPeering.OutscaleAPI = spec.DeepCopy()
+		}
+		isActive := outscaleNetPeering.State == domain.OutscaleNetPeeringStateActive && databaseNetPeering.IsActive()
+		setNetPeeringState(&netPeering, resource.NetPeerings, isActive, now)
+		netPeerings = append(netPeerings, netPeering)
 	}
 
-	if netPeeringID == "" {
-		log.Info("Waiting for NetPeeringRequest to be provisioned")
-		return helpers.RequeueLongDelay, nil
+	var requeue time.Duration
+	for _, netPeering := range netPeerings {
+		if netPeering.State != apiv1.NetPeeringStateActive {
+			requeue = helpers.RequeueLongDelay
+		}
 	}
 
-	err = dbManager.EnsureDatabaseNetPeering(ctx, resource.DatabaseID, netPeeringID)
+	err := r.deleteStaleNetPeerings(ctx, dbManager, resource, netPeerings)
 	if err != nil {
-		return 0, errors.Wrapf(ctx, err, "ensure database net peering id %s", resource.DatabaseID)
+		return resource.NetPeerings, 0, errors.Wrap(ctx, err, "delete stale net peerings")
 	}
-	return 0, nil
+	return netPeerings, requeue, nil
 }
 
+// DeleteNetPeerings deletes the OKS net peerings of the database, and the tracked ones on both sides,
+// including the net peerings created by the operator through the Outscale API.
 func (r NetPeeringReconciler) DeleteNetPeerings(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource) error {
-	log := logf.FromContext(ctx)
 	if resource.DatabaseID == "" {
 		return nil
 	}
 
-	netPeerings, err := r.listExistingNetPeeringsForDatabase(ctx, dbManager, resource)
+	err := r.deleteOKSNetPeerings(ctx, dbManager, resource, nil)
 	if err != nil {
-		return errors.Wrap(ctx, err, "list existing net peerings for database")
+		return errors.Wrap(ctx, err, "delete oks net peerings")
 	}
 
-	for _, netPeering := range netPeerings {
-		log = log.WithValues("netPeering", netPeering.Name())
-		log.Info("Delete Outscale NetPeering resource")
-		err := r.Delete(ctx, netPeering.Object())
+	return r.deleteStaleNetPeerings(ctx, dbManager, resource, nil)
+}
+
+// deleteStaleNetPeerings converges both sides on the given net peerings:
+//   - the NetPeeringRequest resources created by the operator for the OKS net peerings no longer expected are
+//     deleted, with the OKS NetPeering resources tracked by these net peerings,
+//   - the database net peerings tracked in the resource status, whose entry is no longer expected, are deleted,
+//     along with the Outscale net peerings the operator created for them through the Outscale API.
+func (r NetPeeringReconciler) deleteStaleNetPeerings(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource, netPeerings []apiv1.NetPeeringStatus) error {
+	log := logf.FromContext(ctx)
+
+	netPeeringRequests, err := r.listExistingNetPeeringRequestsForDatabase(ctx, resource)
+	if err != nil {
+		return errors.Wrap(ctx, err, "list existing net peering requests for database")
+	}
+	ownedNetPeeringRequests := slices.DeleteFunc(netPeeringRequests, func(netPeeringRequest NetPeeringRequest) bool {
+		return !netPeeringRequest.IsManagedByOperator()
+	})
+
+	isExpected := func(name string) bool {
+		return slices.ContainsFunc(netPeerings, func(netPeering apiv1.NetPeeringStatus) bool {
+			return netPeering.Name == name
+		})
+	}
+
+	var staleOKSNetPeeringIDs []string
+	for _, trackedNetPeering := range resource.NetPeerings {
+		isOKSNetPeering := trackedNetPeering.Name == apiv1.OKSNetPeeringName || trackedNetPeering.RequestName != ""
+		if isOKSNetPeering && trackedNetPeering.OutscaleNetPeeringID != "" && !isExpected(trackedNetPeering.Name) {
+			staleOKSNetPeeringIDs = append(staleOKSNetPeeringIDs, trackedNetPeering.OutscaleNetPeeringID)
+		}
+	}
+	if len(staleOKSNetPeeringIDs) > 0 {
+		err := r.deleteOKSNetPeerings(ctx, dbManager, resource, staleOKSNetPeeringIDs)
+		if err != nil {
+			return errors.Wrap(ctx, err, "delete stale oks net peerings")
+		}
+	}
+
+	for _, netPeeringRequest := range ownedNetPeeringRequests {
+		if isExpected(netPeeringRequest.NetPeeringName()) {
+			continue
+		}
+
+		log.Info("Delete Outscale NetPeeringRequest resource", "netPeeringRequest", netPeeringRequest.Name())
+		err := r.Delete(ctx, netPeeringRequest.Object())
+		if client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(ctx, err, "delete net peering request resource %s", netPeeringRequest.Name())
+		}
+	}
+
+	// Only the net peerings tracked in the status are deleted on the database side, the ones registered by other
+	// means are left untouched.
+	var staleNetPeerings []apiv1.NetPeeringStatus
+	for _, trackedNetPeering := range resource.NetPeerings {
+		if trackedNetPeering.OutscaleNetPeeringID == "" || isExpected(trackedNetPeering.Name) {
+			continue
+		}
+		isStillUsed := slices.ContainsFunc(netPeerings, func(netPeering apiv1.NetPeeringStatus) bool {
+			return netPeering.OutscaleNetPeeringID == trackedNetPeering.OutscaleNetPeeringID
+		})
+		if !isStillUsed {
+			staleNetPeerings = append(staleNetPeerings, trackedNetPeering)
+		}
+	}
+	if len(staleNetPeerings) == 0 {
+		return nil
+	}
+
+	databaseNetPeerings, err := dbManager.GetDatabaseNetPeerings(ctx, resource.DatabaseID)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "get database net peerings id %s", resource.DatabaseID)
+	}
+
+	for _, databaseNetPeering := range databaseNetPeerings {
+		isStale := slices.ContainsFunc(staleNetPeerings, func(netPeering apiv1.NetPeeringStatus) bool {
+			return netPeering.OutscaleNetPeeringID == databaseNetPeering.OutscaleNetPeeringID
+		})
+		if databaseNetPeering.Status == domain.DatabaseNetPeeringStatusDeleted || !isStale {
+			continue
+		}
+
+		log.Info("Delete database net peering", "netPeering", databaseNetPeering.OutscaleNetPeeringID)
+		err := dbManager.DeleteDatabaseNetPeering(ctx, resource.DatabaseID, databaseNetPeering.ID)
 		if err != nil {
-			log.Error(err, "Delete net peering resource", "netPeering", netPeering.Name())
+			return errors.Wrapf(ctx, err, "delete database net peering %s", databaseNetPeering.ID)
 		}
-		log.Info("Outscale NetPeering resource deleted")
 	}
 
+	for _, staleNetPeering := range staleNetPeerings {
+		if staleNetPeering.OutscaleAPI == nil {
+			continue
+		}
+
+		err := r.deleteOutscaleAPINetPeering(ctx, dbManager, resource, *staleNetPeering.OutscaleAPI, staleNetPeering.OutscaleNetPeeringID)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "delete outscale net peering %s", staleNetPeering.Name)
+		}
+	}
 	return nil
 }
 
-func (r NetPeeringReconciler) ensureOKSNetPeeringID(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource) (string, error) {
-	existingNetPeeringsForDatabase, err := r.listExistingNetPeeringsForDatabase(ctx, dbManager, resource)
+// deleteOKSNetPeerings deletes the OKS NetPeering resources of the database. When netPeeringIDs are given,
+// only the NetPeering resources of these net peerings are deleted.
+func (r NetPeeringReconciler) deleteOKSNetPeerings(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource, netPeeringIDs []string) error {
+	log := logf.FromContext(ctx)
+
+	netPeerings, err := r.listExistingNetPeeringsForDatabase(ctx, dbManager, resource)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, "list existing net peerings for database")
+		return errors.Wrap(ctx, err, "list existing net peerings for database")
 	}
 
-	if len(existingNetPeeringsForDatabase) > 0 {
-		return existingNetPeeringsForDatabase[0].Name(), nil
+	for _, netPeering := range netPeerings {
+		if netPeeringIDs != nil && !slices.Contains(netPeeringIDs, netPeering.Name()) {
+			continue
+		}
+
+		log.Info("Delete Outscale NetPeering resource", "netPeering", netPeering.Name())
+		err := r.Delete(ctx, netPeering.Object())
+		if client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(ctx, err, "delete net peering resource %s", netPeering.Name())
+		}
+		log.Info("Outscale NetPeering resource deleted", "netPeering", netPeering.Name())
 	}
 
-	existingNetPeeringRequestsForDatabase, err := r.listExistingNetPeeringRequestsForDatabase(ctx, resource)
-	if err != nil {
-		return "", errors.Wrap(ctx, err, "list existing net peering requests for database")
+	return nil
+}
+
+// ensureOKSNetPeering returns the OKS net peering with the given name, and creates its NetPeeringRequest if none
+// exists. The Outscale net peering ID is empty until the request is provisioned.
+func (r NetPeeringReconciler) ensureOKSNetPeering(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource, name string, netPeeringRequests []NetPeeringRequest, oksNetPeerings []NetPeering) (apiv1.NetPeeringStatus, error) {
+	netPeering := apiv1.NetPeeringStatus{Name: name}
+
+	var requests []NetPeeringRequest
+	for _, netPeeringRequest := range netPeeringRequests {
+		if netPeeringRequest.NetPeeringName() == name {
+			requests = append(requests, netPeeringRequest)
+		}
 	}
 
-	var netPeeringRequest NetPeeringRequest
-	switch len(existingNetPeeringRequestsForDatabase) {
+	switch len(requests) {
 	case 0:
-		netPeeringRequest, err = r.createOKSNetPeeringRequest(ctx, dbManager, resource)
+		// The "oks" net peering adopts the NetPeering resource of the database created without request, e.g.
+		// before the operator managed it, unless another net peering uses it.
+		if name == apiv1.OKSNetPeeringName {
+			for _, oksNetPeering := range oksNetPeerings {
+				if !isOKSNetPeeringUsed(oksNetPeering.Name(), name, netPeeringRequests, resource.NetPeerings) {
+					netPeering.OutscaleNetPeeringID = oksNetPeering.Name()
+					return netPeering, nil
+				}
+			}
+		}
+
+		netPeeringRequest, err := r.createOKSNetPeeringRequest(ctx, dbManager, resource, name)
 		if err != nil {
-			return "", errors.Wrap(ctx, err, "create net peering request")
+			return netPeering, errors.Wrap(ctx, err, "create net peering request")
 		}
+		netPeering.RequestName = netPeeringRequest.Name()
 	case 1:
-		netPeeringRequest = existingNetPeeringRequestsForDatabase[0]
+		netPeering.RequestName = requests[0].Name()
+		netPeering.OutscaleNetPeeringID = requests[0].NetPeeringID()
 	default:
-		return "", errors.New(ctx, "multiple net peering requests found for database")
+		return netPeering, errors.Newf(ctx, "multiple net peering requests found for net peering %s", name)
 	}
+	return netPeering, nil
+}
 
-	netPeeringID := netPeeringRequest.NetPeeringID()
-	return netPeeringID, nil
+// isOKSNetPeeringUsed returns whether the Outscale net peering is used by a request or a tracked net peering
+// other than the given one.
+func isOKSNetPeeringUsed(netPeeringID, name string, netPeeringRequests []NetPeeringRequest, trackedNetPeerings []apiv1.NetPeeringStatus) bool {
+	for _, netPeeringRequest := range netPeeringRequests {
+		if netPeeringRequest.NetPeeringID() == netPeeringID {
+			return true
+		}
+	}
+	for _, trackedNetPeering := range trackedNetPeerings {
+		if trackedNetPeering.Name != name && trackedNetPeering.OutscaleNetPeeringID == netPeeringID {
+			return true
+		}
+	}
+	return false
 }
 
-func (r NetPeeringReconciler) createOKSNetPeeringRequest(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource) (NetPeeringRequest, error) {
+// setNetPeeringState sets the state of the net peering. A net peering pending for longer than
+// NetPeeringTimeout, since first tracked as pending, is failed.
+func setNetPeeringState(netPeering *apiv1.NetPeeringStatus, trackedNetPeerings []apiv1.NetPeeringStatus, isActive bool, now time.Time) {
+	if isActive {
+		netPeering.State = apiv1.NetPeeringStateActive
+		netPeering.PendingSince = nil
+		return
+	}
+
+	pendingSince := metav1.NewTime(now).Rfc3339Copy()
+	for _, trackedNetPeering := range trackedNetPeerings {
+		if trackedNetPeering.Name == netPeering.Name && trackedNetPeering.PendingSince != nil {
+			pendingSince = *trackedNetPeering.PendingSince
+		}
+	}
+
+	netPeering.PendingSince = &pendingSince
+	netPeering.State = apiv1.NetPeeringStatePending
+	if now.Sub(pendingSince.Time) > NetPeeringTimeout {
+		netPeering.State = apiv1.NetPeeringStateFailed
+	}
+}
+
+func (r NetPeeringReconciler) createOKSNetPeeringRequest(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource, name string) (NetPeeringRequest, error) {
 	log := logf.FromContext(ctx)
 	databaseNetworkConfig, err := dbManager.GetDatabaseNetworkConfiguration(ctx, resource.DatabaseID)
 	if err != nil {
 		return NetPeeringRequest{}, errors.Wrapf(ctx, err, "get database network configuration id %s", resource.DatabaseID)
 	}
 
-	log.Info("Create Outscale NetPeeringRequest resource", "outscaleNetID", databaseNetworkConfig.OutscaleNetID, "outscaleAccountID", databaseNetworkConfig.OutscaleAccountID)
-	netPeeringRequest := NewNetPeeringRequest(resource, databaseNetworkConfig)
+	log.Info("Create Outscale NetPeeringRequest resource", "name", name, "outscaleNetID", databaseNetworkConfig.OutscaleNetID, "outscaleAccountID", databaseNetworkConfig.OutscaleAccountID)
+	netPeeringRequest := NewNetPeeringRequest(resource, name, databaseNetworkConfig)
 
 	err = netPeeringRequest.SetControllerReference(resource.Owner, r.Scheme)
 	if err != nil {
@@ -159,7 +392,9 @@ func (r NetPeeringReconciler) listExistingNetPeeringRequestsForDatabase(ctx cont
 		client.InNamespace(resource.Namespace),
 		NetPeeringRequestMatchingLabels(resource.DatabaseID),
 	)
-	if err != nil {
+	if meta.IsNoMatchError(err) {
+		return nil, nil // The OKS resources do not exist outside of OKS clusters.
+	} else if err != nil {
 		return nil, errors.Wrap(ctx, err, "list net peering requests")
 	}
 
@@ -173,7 +408,9 @@ func (r NetPeeringReconciler) listExistingNetPeeringsForDatabase(ctx context.Con
 		netPeerings.ObjectList(),
 		client.InNamespace(resource.Namespace),
 	)
-	if err != nil {
+	if meta.IsNoMatchError(err) {
+		return nil, nil // The OKS resources do not exist outside of OKS clusters.
+	} else if err != nil {
 		return nil, errors.Wrap(ctx, err, "list net peerings")
 	}
 
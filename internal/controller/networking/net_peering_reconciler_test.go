@@ -44,9 +44,9 @@ func TestReconcileOKSNetPeering(t *testing.T) {
 			OutscaleNetID:     "net-id",
 			OutscaleAccountID: "owner-id",
 		}, nil)
-		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-pcx-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive}, nil)
 
-		requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		_, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
 
 		require.NoError(t, err)
 		require.Zero(t, requeue)
@@ -78,12 +78,139 @@ func TestReconcileOKSNetPeering(t *testing.T) {
 			OutscaleAccountID: "owner-id",
 		}, nil)
 
-		requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		_, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
 
 		require.NoError(t, err)
 		require.Equal(t, helpers.RequeueLongDelay, requeue)
 	})
 
+	t.Run("it fails when several requests exist for the net peering", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+
+		clientStub := &netPeeringResourceClient{
+			items: []*unstructured.Unstructured{
+				newNetPeeringRequest("net-peering-request-a", ""),
+				newNetPeeringRequest("net-peering-request-b", "pcx-5678"),
+			},
+		}
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client: clientStub,
+		}
+		resource := DatabaseResource{
+			Name:       "db-resource",
+			Namespace:  "default",
+			DatabaseID: "db-123",
+			Networking: netPeeringEnabledNetworkingSpec(),
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+			OutscaleNetID:     "net-id",
+			OutscaleAccountID: "owner-id",
+		}, nil)
+
+		_, _, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+
+		require.ErrorContains(t, err, "multiple net peering requests found for net peering oks")
+		require.Len(t, clientStub.items, 2)
+	})
+
+	t.Run("it ensures a database net peering per named net peering", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+
+		clientStub := &netPeeringResourceClient{
+			items: []*unstructured.Unstructured{
+				newNamedNetPeeringRequest("staging-net-peering-request", "staging", "pcx-1234"),
+				newNamedNetPeeringRequest("production-net-peering-request", "production", ""),
+			},
+		}
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client: clientStub,
+		}
+		resource := DatabaseResource{
+			Name:       "db-resource",
+			Namespace:  "default",
+			DatabaseID: "db-123",
+			Networking: namedNetPeeringsNetworkingSpec("staging", "production"),
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+			OutscaleNetID:     "net-id",
+			OutscaleAccountID: "owner-id",
+		}, nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-pcx-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive}, nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+
+		require.NoError(t, err)
+		require.Equal(t, helpers.RequeueLongDelay, requeue)
+		require.Len(t, netPeerings, 2)
+		require.Equal(t, apiv1.NetPeeringStatus{
+			Name:                 "staging",
+			RequestName:          "staging-net-peering-request",
+			OutscaleNetPeeringID: "pcx-1234",
+			ScalingoNetPeeringID: "np-pcx-1234",
+			State:                apiv1.NetPeeringStateActive,
+		}, netPeerings[0])
+		require.Equal(t, "production", netPeerings[1].Name)
+		require.Equal(t, "production-net-peering-request", netPeerings[1].RequestName)
+		require.Equal(t, apiv1.NetPeeringStatePending, netPeerings[1].State)
+		require.Len(t, clientStub.items, 2)
+	})
+
+	t.Run("it tears down only the removed named net peering", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+
+		clientStub := &netPeeringResourceClient{
+			items: []*unstructured.Unstructured{
+				newNetPeering("default", "pcx-1234", netPeeringStatusStateActive, "net-id"),
+				newNetPeering("default", "pcx-5678", netPeeringStatusStateActive, "net-id"),
+				newNamedNetPeeringRequest("staging-net-peering-request", "staging", "pcx-1234"),
+				newNamedNetPeeringRequest("production-net-peering-request", "production", "pcx-5678"),
+			},
+		}
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client: clientStub,
+		}
+		resource := DatabaseResource{
+			Name:       "db-resource",
+			Namespace:  "default",
+			DatabaseID: "db-123",
+			Networking: namedNetPeeringsNetworkingSpec("staging"),
+			NetPeerings: []apiv1.NetPeeringStatus{
+				{Name: "staging", RequestName: "staging-net-peering-request", OutscaleNetPeeringID: "pcx-1234", ScalingoNetPeeringID: "np-pcx-1234", State: apiv1.NetPeeringStateActive},
+				{Name: "production", RequestName: "production-net-peering-request", OutscaleNetPeeringID: "pcx-5678", ScalingoNetPeeringID: "np-pcx-5678", State: apiv1.NetPeeringStateActive},
+			},
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+			OutscaleNetID:     "net-id",
+			OutscaleAccountID: "owner-id",
+		}, nil).Times(2)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-pcx-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive}, nil)
+		databaseManager.EXPECT().GetDatabaseNetPeerings(ctx, "db-123").Return([]domain.DatabaseNetPeering{
+			{ID: "np-pcx-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive},
+			{ID: "np-pcx-5678", OutscaleNetPeeringID: "pcx-5678", Status: domain.DatabaseNetPeeringStatusActive},
+		}, nil)
+		databaseManager.EXPECT().DeleteDatabaseNetPeering(ctx, "db-123", "np-pcx-5678").Return(nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+
+		require.NoError(t, err)
+		require.Zero(t, requeue)
+		require.Len(t, netPeerings, 1)
+		require.Equal(t, "staging", netPeerings[0].Name)
+		require.ElementsMatch(t, []string{"pcx-1234", "staging-net-peering-request"}, resourceNames(clientStub.items))
+	})
+
 	t.Run("ensures database net peering from existing active net peering", func(t *testing.T) {
 		ctx := t.Context()
 		ctrl := gomock.NewController(t)
@@ -91,8 +218,6 @@ func TestReconcileOKSNetPeering(t *testing.T) {
 		clientStub := &netPeeringResourceClient{
 			items: []*unstructured.Unstructured{
 				newNetPeering("default", "pcx-1234", netPeeringStatusStateActive, "net-id"),
-				newNetPeeringRequest("net-peering-request-a", "pcx-5678"),
-				newNetPeeringRequest("net-peering-request-b", "pcx-9012"),
 			},
 		}
 		databaseManager := databasemock.NewMockManager(ctrl)
@@ -111,15 +236,88 @@ func TestReconcileOKSNetPeering(t *testing.T) {
 			OutscaleNetID:     "net-id",
 			OutscaleAccountID: "owner-id",
 		}, nil)
-		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-pcx-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive}, nil)
 
-		requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		_, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
 
 		require.NoError(t, err)
 		require.Zero(t, requeue)
 	})
 }
 
+func TestReconcileDisabledNetPeering(t *testing.T) {
+	t.Run("it deletes the owned requests and the tracked database net peerings", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+
+		foreignNetPeeringRequest := newNetPeeringRequest("foreign-net-peering-request", "")
+		foreignNetPeeringRequest.SetLabels(map[string]string{netPeeringRequestDatabaseIDLabel: "db-123"})
+		clientStub := &netPeeringResourceClient{
+			items: []*unstructured.Unstructured{
+				newNetPeering("default", "pcx-1234", netPeeringStatusStateActive, "net-id"),
+				newNetPeeringRequest("net-peering-request", "pcx-1234"),
+				foreignNetPeeringRequest,
+			},
+		}
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client: clientStub,
+		}
+		resource := DatabaseResource{
+			Name:       "db-resource",
+			Namespace:  "default",
+			DatabaseID: "db-123",
+			NetPeerings: []apiv1.NetPeeringStatus{
+				{Name: apiv1.OKSNetPeeringName, RequestName: "net-peering-request", OutscaleNetPeeringID: "pcx-1234", ScalingoNetPeeringID: "np-1234"},
+			},
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+			OutscaleNetID:     "net-id",
+			OutscaleAccountID: "owner-id",
+		}, nil).Times(2)
+		databaseManager.EXPECT().GetDatabaseNetPeerings(ctx, "db-123").Return([]domain.DatabaseNetPeering{
+			{ID: "np-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive},
+			{ID: "np-old", OutscaleNetPeeringID: "pcx-old", Status: domain.DatabaseNetPeeringStatusActive},
+			{ID: "np-deleted", OutscaleNetPeeringID: "pcx-deleted", Status: domain.DatabaseNetPeeringStatusDeleted},
+		}, nil)
+		databaseManager.EXPECT().DeleteDatabaseNetPeering(ctx, "db-123", "np-1234").Return(nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+
+		require.NoError(t, err)
+		require.Zero(t, requeue)
+		require.Empty(t, netPeerings)
+		require.Equal(t, []string{"foreign-net-peering-request"}, resourceNames(clientStub.items))
+	})
+
+	t.Run("it leaves the database net peerings never managed by the operator", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+
+		clientStub := &netPeeringResourceClient{}
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client: clientStub,
+		}
+		resource := DatabaseResource{
+			Name:       "db-resource",
+			Namespace:  "default",
+			DatabaseID: "db-123",
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+			OutscaleNetID:     "net-id",
+			OutscaleAccountID: "owner-id",
+		}, nil)
+
+		_, _, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+	})
+}
+
 func TestDeleteOKSNetPeerings(t *testing.T) {
 	t.Parallel()
 	ctx := t.Context()
@@ -160,10 +358,43 @@ func TestDeleteOKSNetPeerings(t *testing.T) {
 	)
 }
 
+func TestDeleteOKSNetPeerings_DeletionFailure(t *testing.T) {
+	ctx := t.Context()
+	ctrl := gomock.NewController(t)
+
+	clientStub := &netPeeringResourceClient{
+		items: []*unstructured.Unstructured{
+			newNetPeering("default", "net-peering-a", netPeeringStatusStateActive, "net-id"),
+		},
+		deleteErr: errors.New(ctx, "boom"),
+	}
+	databaseManager := databasemock.NewMockManager(ctrl)
+
+	reconciler := NetPeeringReconciler{
+		Client: clientStub,
+	}
+	resource := DatabaseResource{
+		Name:       "db-resource",
+		Namespace:  "default",
+		DatabaseID: "db-123",
+	}
+
+	databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+		OutscaleNetID:     "net-id",
+		OutscaleAccountID: "owner-id",
+	}, nil)
+
+	// The error is returned so that the deletion is retried.
+	err := reconciler.DeleteNetPeerings(ctx, databaseManager, resource)
+	require.ErrorContains(t, err, "delete net peering resource net-peering-a: boom")
+	require.Len(t, clientStub.items, 1)
+}
+
 type netPeeringResourceClient struct {
 	client.Client
 
-	items []*unstructured.Unstructured
+	items     []*unstructured.Unstructured
+	deleteErr error
 }
 
 func (c *netPeeringResourceClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
@@ -193,6 +424,10 @@ func (c *netPeeringResourceClient) List(ctx context.Context, list client.ObjectL
 }
 
 func (c *netPeeringResourceClient) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	if c.deleteErr != nil {
+		return c.deleteErr
+	}
+
 	filteredItems := make([]*unstructured.Unstructured, 0, len(c.items))
 	for _, item := range c.items {
 		if item.GetNamespace() == obj.GetNamespace() && item.GetName() == obj.GetName() {
@@ -225,7 +460,10 @@ func newNetPeeringRequest(name, netPeeringID string) *unstructured.Unstructured
 	object.SetGroupVersionKind(helpers.OutscaleNetPeeringRequestGVK)
 	object.SetNamespace("default")
 	object.SetName(name)
-	object.SetLabels(map[string]string{netPeeringRequestDatabaseIDLabel: "db-123"})
+	object.SetLabels(map[string]string{
+		netPeeringRequestManagedByLabel:  netPeeringRequestManagerName,
+		netPeeringRequestDatabaseIDLabel: "db-123",
+	})
 	object.Object[netPeeringRequestStatusField] = map[string]any{
 		netPeeringRequestNetPeeringIDField: netPeeringID,
 	}
@@ -233,6 +471,30 @@ func newNetPeeringRequest(name, netPeeringID string) *unstructured.Unstructured
 	return object
 }
 
+func newNamedNetPeeringRequest(name, netPeeringName, netPeeringID string) *unstructured.Unstructured {
+	object := newNetPeeringRequest(name, netPeeringID)
+	labels := object.GetLabels()
+	labels[netPeeringRequestNetPeeringNameLabel] = netPeeringName
+	object.SetLabels(labels)
+
+	return object
+}
+
+func namedNetPeeringsNetworkingSpec(names ...string) apiv1.NetworkingSpec {
+	netPeerings := make([]apiv1.OutscaleOKSNetPeeringSpec, 0, len(names))
+	for _, name := range names {
+		netPeerings = append(netPeerings, apiv1.OutscaleOKSNetPeeringSpec{Name: name})
+	}
+
+	return apiv1.NetworkingSpec{
+		Outscale: &apiv1.OutscaleSpec{
+			OKS: &apiv1.OutscaleOKSSpec{
+				NetPeerings: netPeerings,
+			},
+		},
+	}
+}
+
 func netPeeringEnabledNetworkingSpec() apiv1.NetworkingSpec {
 	return apiv1.NetworkingSpec{
 		Outscale: &apiv1.OutscaleSpec{
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
 	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
 	"github.com/Scalingo/scalingo-operator/internal/domain"
 )
@@ -25,9 +26,10 @@ const (
 	netPeeringRequestSpecField       = "spec"
 	netPeeringRequestStatusField     = "status"
 
-	netPeeringRequestManagedByLabel  = "app.kubernetes.io/managed-by"
-	netPeeringRequestDatabaseIDLabel = "scalingo.com/database-id"
-	netPeeringRequestManagerName     = "scalingo-operator"
+	netPeeringRequestManagedByLabel      = "app.kubernetes.io/managed-by"
+	netPeeringRequestDatabaseIDLabel     = "scalingo.com/database-id"
+	netPeeringRequestNetPeeringNameLabel = "scalingo.com/net-peering-name"
+	netPeeringRequestManagerName         = "scalingo-operator"
 
 	netPeeringRequestNetPeeringIDField    = "netPeeringId"
 	netPeeringRequestAccepterNetIDField   = "accepterNetId"
@@ -49,9 +51,9 @@ func NewNetPeeringRequestList() NetPeeringRequestList {
 	return NetPeeringRequestList{objects: objects}
 }
 
-func NewNetPeeringRequest(resource DatabaseResource, databaseNetworkConfig domain.DatabaseNetworkConfiguration) NetPeeringRequest {
+func NewNetPeeringRequest(resource DatabaseResource, netPeeringName string, databaseNetworkConfig domain.DatabaseNetworkConfiguration) NetPeeringRequest {
 	now := time.Now().UnixMilli()
-	resourceName := fmt.Sprintf("%s-%s-%d", resource.Name, netPeeringRequestSuffix, now)
+	resourceName := fmt.Sprintf("%s-%s-%s-%d", resource.Name, netPeeringName, netPeeringRequestSuffix, now)
 	apiVersion, kind := helpers.OutscaleNetPeeringRequestGVK.ToAPIVersionAndKind()
 
 	object := &unstructured.Unstructured{
@@ -62,8 +64,9 @@ func NewNetPeeringRequest(resource DatabaseResource, databaseNetworkConfig domai
 				netPeeringRequestNameField:      resourceName,
 				netPeeringRequestNamespaceField: resource.Namespace,
 				netPeeringRequestLabelsField: map[string]any{
-					netPeeringRequestManagedByLabel:  netPeeringRequestManagerName,
-					netPeeringRequestDatabaseIDLabel: resource.DatabaseID,
+					netPeeringRequestManagedByLabel:      netPeeringRequestManagerName,
+					netPeeringRequestDatabaseIDLabel:     resource.DatabaseID,
+					netPeeringRequestNetPeeringNameLabel: netPeeringName,
 				},
 			},
 			netPeeringRequestSpecField: map[string]any{
@@ -97,6 +100,25 @@ func (l NetPeeringRequestList) Items() []NetPeeringRequest {
 	return requests
 }
 
+func (r NetPeeringRequest) Name() string {
+	return r.object.GetName()
+}
+
+// IsManagedByOperator returns whether the request was created by the operator.
+func (r NetPeeringRequest) IsManagedByOperator() bool {
+	return r.object.GetLabels()[netPeeringRequestManagedByLabel] == netPeeringRequestManagerName
+}
+
+// NetPeeringName returns the name of the net peering entry of the request. The requests created before the
+// net peerings were named belong to the "oks" net peering.
+func (r NetPeeringRequest) NetPeeringName() string {
+	name := r.object.GetLabels()[netPeeringRequestNetPeeringNameLabel]
+	if name == "" {
+		return apiv1.OKSNetPeeringName
+	}
+	return name
+}
+
 func (r NetPeeringRequest) Object() client.Object {
 	return r.object
 }
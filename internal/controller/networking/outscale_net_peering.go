@@ -0,0 +1,132 @@
+package networking
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/outscale"
+	outscalebase "github.com/Scalingo/scalingo-operator/internal/boundaries/out/outscale/base"
+	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	databaseusecases "github.com/Scalingo/scalingo-operator/internal/usecases/database"
+)
+
+const (
+	defaultOutscaleAccessKeyKey = "access_key"
+	defaultOutscaleSecretKeyKey = "secret_key"
+)
+
+// NewOutscaleClientFunc creates an Outscale API client, as outscalebase.NewClient does.
+type NewOutscaleClientFunc func(ctx context.Context, endpoint, region, accessKey, secretKey string) (outscale.Client, error)
+
+// ensureOutscaleAPINetPeering returns the peering between the cluster Net and the database Net, and creates
+// it through the Outscale API if none is usable, in which case isCreated is true. The database Net belongs to
+// the Scalingo account, so only Scalingo can accept the peering: it does so once the peering is registered on
+// the database.
+func (r NetPeeringReconciler) ensureOutscaleAPINetPeering(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource, spec apiv1.OutscaleNetPeeringSpec) (domain.OutscaleNetPeering, bool, error) {
+	log := logf.FromContext(ctx)
+
+	outscaleClient, err := r.newOutscaleClient(ctx, resource, spec)
+	if err != nil {
+		return domain.OutscaleNetPeering{}, false, errors.Wrap(ctx, err, "new outscale client")
+	}
+
+	databaseNetworkConfig, err := dbManager.GetDatabaseNetworkConfiguration(ctx, resource.DatabaseID)
+	if err != nil {
+		return domain.OutscaleNetPeering{}, false, errors.Wrapf(ctx, err, "get database network configuration id %s", resource.DatabaseID)
+	}
+
+	netPeerings, err := outscaleClient.ListNetPeerings(ctx, spec.SourceNetID, databaseNetworkConfig.OutscaleNetID)
+	if err != nil {
+		return domain.OutscaleNetPeering{}, false, errors.Wrap(ctx, err, "list outscale net peerings")
+	}
+	for _, netPeering := range netPeerings {
+		if netPeering.IsUsable() && netPeering.SourceAccountID == spec.AccountID {
+			return netPeering, false, nil
+		}
+	}
+
+	log.Info("Create Outscale net peering", "name", spec.Name, "sourceNetID", spec.SourceNetID, "outscaleNetID", databaseNetworkConfig.OutscaleNetID, "outscaleAccountID", databaseNetworkConfig.OutscaleAccountID)
+	netPeering, err := outscaleClient.CreateNetPeering(ctx, spec.SourceNetID, databaseNetworkConfig.OutscaleNetID, databaseNetworkConfig.OutscaleAccountID)
+	if err != nil {
+		return domain.OutscaleNetPeering{}, false, errors.Wrap(ctx, err, "create outscale net peering")
+	}
+	log.Info("Outscale net peering created", "netPeering", netPeering.ID)
+
+	return netPeering, true, nil
+}
+
+// isOutscaleAPINetPeeringCreated returns whether the Outscale net peering is tracked as created by the
+// operator for the given net peering.
+func isOutscaleAPINetPeeringCreated(trackedNetPeerings []apiv1.NetPeeringStatus, name, netPeeringID string) bool {
+	return slices.ContainsFunc(trackedNetPeerings, func(trackedNetPeering apiv1.NetPeeringStatus) bool {
+		return trackedNetPeering.Name == name && trackedNetPeering.OutscaleNetPeeringID == netPeeringID && trackedNetPeering.OutscaleAPI != nil
+	})
+}
+
+// deleteOutscaleAPINetPeering deletes the peering between the cluster Net and the database Net through the
+// Outscale API, unless already deleted.
+func (r NetPeeringReconciler) deleteOutscaleAPINetPeering(ctx context.Context, dbManager databaseusecases.Manager, resource DatabaseResource, spec apiv1.OutscaleNetPeeringSpec, netPeeringID string) error {
+	log := logf.FromContext(ctx)
+
+	outscaleClient, err := r.newOutscaleClient(ctx, resource, spec)
+	if err != nil {
+		return errors.Wrap(ctx, err, "new outscale client")
+	}
+
+	databaseNetworkConfig, err := dbManager.GetDatabaseNetworkConfiguration(ctx, resource.DatabaseID)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "get database network configuration id %s", resource.DatabaseID)
+	}
+
+	netPeerings, err := outscaleClient.ListNetPeerings(ctx, spec.SourceNetID, databaseNetworkConfig.OutscaleNetID)
+	if err != nil {
+		return errors.Wrap(ctx, err, "list outscale net peerings")
+	}
+
+	for _, netPeering := range netPeerings {
+		if netPeering.ID != netPeeringID || !netPeering.IsUsable() {
+			continue
+		}
+
+		log.Info("Delete Outscale net peering", "netPeering", netPeering.ID)
+		err := outscaleClient.DeleteNetPeering(ctx, netPeering.ID)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "delete outscale net peering %s", netPeering.ID)
+		}
+	}
+	return nil
+}
+
+func (r NetPeeringReconciler) newOutscaleClient(ctx context.Context, resource DatabaseResource, spec apiv1.OutscaleNetPeeringSpec) (outscale.Client, error) {
+	secretManager := helpers.NewSecretManager(r.Client, resource.Owner)
+
+	accessKey, err := secretManager.GetSecret(ctx, domain.Secret{
+		Namespace: resource.Namespace,
+		Name:      spec.CredentialsSecret.Name,
+		Key:       cmp.Or(spec.CredentialsSecret.AccessKeyKey, defaultOutscaleAccessKeyKey),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "get outscale access key")
+	}
+
+	secretKey, err := secretManager.GetSecret(ctx, domain.Secret{
+		Namespace: resource.Namespace,
+		Name:      spec.CredentialsSecret.Name,
+		Key:       cmp.Or(spec.CredentialsSecret.SecretKeyKey, defaultOutscaleSecretKeyKey),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "get outscale secret key")
+	}
+
+	newOutscaleClient := r.NewOutscaleClient
+	if newOutscaleClient == nil {
+		newOutscaleClient = outscalebase.NewClient
+	}
+	return newOutscaleClient(ctx, spec.Endpoint, spec.Region, accessKey, secretKey)
+}
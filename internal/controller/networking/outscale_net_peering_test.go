@@ -0,0 +1,438 @@
+package networking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/outscale"
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/outscale/outscalemock"
+	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	"github.com/Scalingo/scalingo-operator/internal/usecases/database/databasemock"
+)
+
+func TestReconcileOutscaleAPINetPeering(t *testing.T) {
+	resource := DatabaseResource{
+		Name:       "db-resource",
+		Namespace:  "default",
+		DatabaseID: "db-123",
+		Networking: outscaleAPINetPeeringNetworkingSpec(),
+	}
+	netConfig := domain.DatabaseNetworkConfiguration{
+		OutscaleNetID:     "vpc-db",
+		OutscaleAccountID: "123456789012",
+	}
+
+	t.Run("it creates the net peering when none is usable", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		outscaleClient := outscalemock.NewMockClient(ctrl)
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client:            &outscaleCredentialsClient{},
+			NewOutscaleClient: newOutscaleClientStub(t, outscaleClient),
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(netConfig, nil)
+		outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-source", "vpc-db").Return([]domain.OutscaleNetPeering{
+			{ID: "pcx-deleted", State: "deleted", SourceAccountID: "210987654321"},
+		}, nil)
+		outscaleClient.EXPECT().CreateNetPeering(ctx, "vpc-source", "vpc-db", "123456789012").Return(domain.OutscaleNetPeering{
+			ID:    "pcx-1234",
+			State: domain.OutscaleNetPeeringStatePendingAcceptance,
+		}, nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-pcx-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive}, nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+		require.Equal(t, helpers.RequeueLongDelay, requeue)
+		require.Len(t, netPeerings, 1)
+		require.Equal(t, "pcx-1234", netPeerings[0].OutscaleNetPeeringID)
+		require.Equal(t, "np-pcx-1234", netPeerings[0].ScalingoNetPeeringID)
+		require.Equal(t, apiv1.NetPeeringStatePending, netPeerings[0].State)
+		require.NotNil(t, netPeerings[0].PendingSince)
+		require.Equal(t, "vpc-source", netPeerings[0].OutscaleAPI.SourceNetID)
+	})
+
+	t.Run("it reuses the existing net peering", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		outscaleClient := outscalemock.NewMockClient(ctrl)
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client:            &outscaleCredentialsClient{},
+			NewOutscaleClient: newOutscaleClientStub(t, outscaleClient),
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(netConfig, nil)
+		outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-source", "vpc-db").Return([]domain.OutscaleNetPeering{
+			{ID: "pcx-1234", State: domain.OutscaleNetPeeringStateActive, SourceAccountID: "210987654321"},
+		}, nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-pcx-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive}, nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+		require.Zero(t, requeue)
+		require.Nil(t, netPeerings[0].OutscaleAPI)
+	})
+
+	t.Run("it registers the pending net peering on the database, where Scalingo accepts it", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		outscaleClient := outscalemock.NewMockClient(ctrl)
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client:            &outscaleCredentialsClient{},
+			NewOutscaleClient: newOutscaleClientStub(t, outscaleClient),
+		}
+
+		// The operator only creates and registers the net peering, it never accepts it.
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(netConfig, nil).Times(2)
+		gomock.InOrder(
+			outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-source", "vpc-db").Return([]domain.OutscaleNetPeering{
+				{ID: "pcx-1234", State: domain.OutscaleNetPeeringStatePendingAcceptance, SourceAccountID: "210987654321"},
+			}, nil),
+			outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-source", "vpc-db").Return([]domain.OutscaleNetPeering{
+				{ID: "pcx-1234", State: domain.OutscaleNetPeeringStateActive, SourceAccountID: "210987654321"},
+			}, nil),
+		)
+		gomock.InOrder(
+			databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-1234", OutscaleNetPeeringID: "pcx-1234"}, nil),
+			databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-1234", OutscaleNetPeeringID: "pcx-1234", Status: domain.DatabaseNetPeeringStatusActive}, nil),
+		)
+		databaseManager.EXPECT().GetDatabaseNetPeerings(ctx, "db-123").Return([]domain.DatabaseNetPeering{{ID: "np-1234", OutscaleNetPeeringID: "pcx-1234"}}, nil).AnyTimes()
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+		require.Equal(t, helpers.RequeueLongDelay, requeue)
+		require.Equal(t, apiv1.NetPeeringStatePending, netPeerings[0].State)
+
+		resource := resource
+		resource.NetPeerings = netPeerings
+		netPeerings, requeue, err = reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+		require.Zero(t, requeue)
+		require.Equal(t, apiv1.NetPeeringStateActive, netPeerings[0].State)
+	})
+
+	t.Run("it fails the net peering pending for longer than the timeout", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		outscaleClient := outscalemock.NewMockClient(ctrl)
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client:            &outscaleCredentialsClient{},
+			NewOutscaleClient: newOutscaleClientStub(t, outscaleClient),
+		}
+		pendingSince := metav1.NewTime(time.Now().Add(-NetPeeringTimeout - time.Minute))
+		resource := resource
+		resource.NetPeerings = []apiv1.NetPeeringStatus{{
+			Name:                 "default",
+			OutscaleNetPeeringID: "pcx-1234",
+			State:                apiv1.NetPeeringStatePending,
+			PendingSince:         &pendingSince,
+		}}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(netConfig, nil)
+		outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-source", "vpc-db").Return([]domain.OutscaleNetPeering{
+			{ID: "pcx-1234", State: domain.OutscaleNetPeeringStatePendingAcceptance, SourceAccountID: "210987654321"},
+		}, nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-1234").Return(domain.DatabaseNetPeering{ID: "np-1234", OutscaleNetPeeringID: "pcx-1234"}, nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+		require.Equal(t, helpers.RequeueLongDelay, requeue)
+		require.Equal(t, []apiv1.NetPeeringStatus{{
+			Name:                 "default",
+			OutscaleNetPeeringID: "pcx-1234",
+			ScalingoNetPeeringID: "np-1234",
+			State:                apiv1.NetPeeringStateFailed,
+			PendingSince:         &pendingSince,
+		}}, netPeerings)
+	})
+
+	t.Run("it fails when the credentials secret does not exist", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client: &outscaleCredentialsClient{missing: true},
+		}
+
+		_, _, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.ErrorContains(t, err, "get outscale access key")
+	})
+}
+
+func TestReconcileOutscaleAPINetPeerings(t *testing.T) {
+	t.Run("it ensures every net peering and deletes the removed tracked ones on the database side", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		outscaleClient := outscalemock.NewMockClient(ctrl)
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client:            &outscaleCredentialsClient{},
+			NewOutscaleClient: newOutscaleClientStub(t, outscaleClient),
+		}
+		staging := outscaleAPINetPeeringNetworkingSpec().Outscale.NetPeering
+		staging.Name = "staging"
+		staging.SourceNetID = "vpc-staging"
+		production := outscaleAPINetPeeringNetworkingSpec().Outscale.NetPeering
+		production.Name = "production"
+		production.SourceNetID = "vpc-production"
+
+		resource := DatabaseResource{
+			Name:       "db-resource",
+			Namespace:  "default",
+			DatabaseID: "db-123",
+			Networking: apiv1.NetworkingSpec{
+				Outscale: &apiv1.OutscaleSpec{
+					NetPeerings: []apiv1.OutscaleNetPeeringSpec{*staging, *production},
+				},
+			},
+			NetPeerings: []apiv1.NetPeeringStatus{
+				{Name: "staging", OutscaleNetPeeringID: "pcx-staging"},
+				{Name: "blue", OutscaleNetPeeringID: "pcx-blue"},
+				{Name: "green"},
+			},
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+			OutscaleNetID:     "vpc-db",
+			OutscaleAccountID: "123456789012",
+		}, nil).Times(2)
+		outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-staging", "vpc-db").Return([]domain.OutscaleNetPeering{
+			{ID: "pcx-staging", State: domain.OutscaleNetPeeringStateActive, SourceAccountID: "210987654321"},
+		}, nil)
+		outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-production", "vpc-db").Return(nil, nil)
+		outscaleClient.EXPECT().CreateNetPeering(ctx, "vpc-production", "vpc-db", "123456789012").Return(domain.OutscaleNetPeering{
+			ID:    "pcx-production",
+			State: domain.OutscaleNetPeeringStatePendingAcceptance,
+		}, nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-staging").Return(domain.DatabaseNetPeering{ID: "np-pcx-staging", OutscaleNetPeeringID: "pcx-staging", Status: domain.DatabaseNetPeeringStatusActive}, nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-production").Return(domain.DatabaseNetPeering{ID: "np-pcx-production", OutscaleNetPeeringID: "pcx-production", Status: domain.DatabaseNetPeeringStatusActive}, nil)
+		databaseManager.EXPECT().GetDatabaseNetPeerings(ctx, "db-123").Return([]domain.DatabaseNetPeering{
+			{ID: "np-staging", OutscaleNetPeeringID: "pcx-staging"},
+			{ID: "np-blue", OutscaleNetPeeringID: "pcx-blue"},
+			{ID: "np-foreign", OutscaleNetPeeringID: "pcx-foreign"},
+		}, nil)
+		databaseManager.EXPECT().DeleteDatabaseNetPeering(ctx, "db-123", "np-blue").Return(nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+		require.Equal(t, helpers.RequeueLongDelay, requeue)
+		require.Len(t, netPeerings, 2)
+		require.Equal(t, apiv1.NetPeeringStatus{
+			Name:                 "staging",
+			OutscaleNetPeeringID: "pcx-staging",
+			ScalingoNetPeeringID: "np-pcx-staging",
+			State:                apiv1.NetPeeringStateActive,
+		}, netPeerings[0])
+		require.Equal(t, "production", netPeerings[1].Name)
+		require.Equal(t, "pcx-production", netPeerings[1].OutscaleNetPeeringID)
+		require.Equal(t, apiv1.NetPeeringStatePending, netPeerings[1].State)
+	})
+
+	t.Run("it deletes the Outscale net peering created for a removed entry", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		outscaleClient := outscalemock.NewMockClient(ctrl)
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client:            &outscaleCredentialsClient{},
+			NewOutscaleClient: newOutscaleClientStub(t, outscaleClient),
+		}
+		staging := outscaleAPINetPeeringNetworkingSpec().Outscale.NetPeering
+		staging.Name = "staging"
+		staging.SourceNetID = "vpc-staging"
+		blue := outscaleAPINetPeeringNetworkingSpec().Outscale.NetPeering
+		blue.Name = "blue"
+		blue.SourceNetID = "vpc-blue"
+
+		resource := DatabaseResource{
+			Name:       "db-resource",
+			Namespace:  "default",
+			DatabaseID: "db-123",
+			Networking: apiv1.NetworkingSpec{
+				Outscale: &apiv1.OutscaleSpec{
+					NetPeerings: []apiv1.OutscaleNetPeeringSpec{*staging},
+				},
+			},
+			NetPeerings: []apiv1.NetPeeringStatus{
+				{Name: "staging", OutscaleNetPeeringID: "pcx-staging", OutscaleAPI: staging},
+				{Name: "blue", OutscaleNetPeeringID: "pcx-blue", OutscaleAPI: blue},
+			},
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+			OutscaleNetID:     "vpc-db",
+			OutscaleAccountID: "123456789012",
+		}, nil).Times(2)
+		outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-staging", "vpc-db").Return([]domain.OutscaleNetPeering{
+			{ID: "pcx-staging", State: domain.OutscaleNetPeeringStateActive, SourceAccountID: "210987654321"},
+		}, nil)
+		databaseManager.EXPECT().EnsureDatabaseNetPeering(ctx, "db-123", "pcx-staging").Return(domain.DatabaseNetPeering{ID: "np-staging", OutscaleNetPeeringID: "pcx-staging", Status: domain.DatabaseNetPeeringStatusActive}, nil)
+		databaseManager.EXPECT().GetDatabaseNetPeerings(ctx, "db-123").Return([]domain.DatabaseNetPeering{
+			{ID: "np-staging", OutscaleNetPeeringID: "pcx-staging"},
+			{ID: "np-blue", OutscaleNetPeeringID: "pcx-blue"},
+		}, nil)
+		databaseManager.EXPECT().DeleteDatabaseNetPeering(ctx, "db-123", "np-blue").Return(nil)
+		outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-blue", "vpc-db").Return([]domain.OutscaleNetPeering{
+			{ID: "pcx-blue", State: domain.OutscaleNetPeeringStateActive},
+			{ID: "pcx-foreign", State: domain.OutscaleNetPeeringStateActive},
+		}, nil)
+		outscaleClient.EXPECT().DeleteNetPeering(ctx, "pcx-blue").Return(nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+		require.Zero(t, requeue)
+		require.Equal(t, []apiv1.NetPeeringStatus{{
+			Name:                 "staging",
+			OutscaleNetPeeringID: "pcx-staging",
+			ScalingoNetPeeringID: "np-staging",
+			OutscaleAPI:          staging,
+			State:                apiv1.NetPeeringStateActive,
+		}}, netPeerings)
+	})
+
+	t.Run("it deletes the tracked net peerings once disabled", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		databaseManager := databasemock.NewMockManager(ctrl)
+
+		reconciler := NetPeeringReconciler{
+			Client: &outscaleCredentialsClient{},
+		}
+		resource := DatabaseResource{
+			Name:        "db-resource",
+			Namespace:   "default",
+			DatabaseID:  "db-123",
+			NetPeerings: []apiv1.NetPeeringStatus{{Name: "default", OutscaleNetPeeringID: "pcx-1234"}},
+		}
+
+		databaseManager.EXPECT().GetDatabaseNetPeerings(ctx, "db-123").Return([]domain.DatabaseNetPeering{
+			{ID: "np-1234", OutscaleNetPeeringID: "pcx-1234"},
+		}, nil)
+		databaseManager.EXPECT().DeleteDatabaseNetPeering(ctx, "db-123", "np-1234").Return(nil)
+
+		netPeerings, requeue, err := reconciler.Reconcile(ctx, databaseManager, resource, DatabaseState{Available: true})
+		require.NoError(t, err)
+		require.Zero(t, requeue)
+		require.Empty(t, netPeerings)
+	})
+}
+
+func TestDeleteOutscaleAPINetPeerings(t *testing.T) {
+	ctx := t.Context()
+	ctrl := gomock.NewController(t)
+	outscaleClient := outscalemock.NewMockClient(ctrl)
+	databaseManager := databasemock.NewMockManager(ctrl)
+
+	reconciler := NetPeeringReconciler{
+		Client:            &outscaleCredentialsClient{},
+		NewOutscaleClient: newOutscaleClientStub(t, outscaleClient),
+	}
+	networkingSpec := outscaleAPINetPeeringNetworkingSpec()
+	resource := DatabaseResource{
+		Name:       "db-resource",
+		Namespace:  "default",
+		DatabaseID: "db-123",
+		Networking: networkingSpec,
+		NetPeerings: []apiv1.NetPeeringStatus{
+			{Name: "default", OutscaleNetPeeringID: "pcx-created", OutscaleAPI: networkingSpec.Outscale.NetPeering},
+			{Name: "reused", OutscaleNetPeeringID: "pcx-reused"},
+		},
+	}
+
+	// Only the net peering created by the operator is deleted on the Outscale side.
+	databaseManager.EXPECT().GetDatabaseNetPeerings(ctx, "db-123").Return([]domain.DatabaseNetPeering{
+		{ID: "np-created", OutscaleNetPeeringID: "pcx-created"},
+		{ID: "np-reused", OutscaleNetPeeringID: "pcx-reused"},
+	}, nil)
+	databaseManager.EXPECT().DeleteDatabaseNetPeering(ctx, "db-123", "np-created").Return(nil)
+	databaseManager.EXPECT().DeleteDatabaseNetPeering(ctx, "db-123", "np-reused").Return(nil)
+	databaseManager.EXPECT().GetDatabaseNetworkConfiguration(ctx, "db-123").Return(domain.DatabaseNetworkConfiguration{
+		OutscaleNetID:     "vpc-db",
+		OutscaleAccountID: "123456789012",
+	}, nil)
+	outscaleClient.EXPECT().ListNetPeerings(ctx, "vpc-source", "vpc-db").Return([]domain.OutscaleNetPeering{
+		{ID: "pcx-created", State: domain.OutscaleNetPeeringStatePendingAcceptance},
+		{ID: "pcx-reused", State: domain.OutscaleNetPeeringStateActive},
+	}, nil)
+	outscaleClient.EXPECT().DeleteNetPeering(ctx, "pcx-created").Return(nil)
+
+	err := reconciler.DeleteNetPeerings(ctx, databaseManager, resource)
+	require.NoError(t, err)
+}
+
+type outscaleCredentialsClient struct {
+	client.Client
+
+	missing bool
+}
+
+func (c *outscaleCredentialsClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return errors.New(ctx, "expected secret")
+	}
+	if c.missing || key.Namespace != "default" || key.Name != "outscale-credentials" {
+		return errors.Newf(ctx, "secret %s not found", key)
+	}
+
+	secret.Data = map[string][]byte{
+		"access_key": []byte("AK"),
+		"secret_key": []byte("SK"),
+	}
+	return nil
+}
+
+// List fails as on the clusters which do not run on OKS, where the NetPeering kind does not exist.
+func (c *outscaleCredentialsClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	return &meta.NoKindMatchError{GroupKind: list.GetObjectKind().GroupVersionKind().GroupKind()}
+}
+
+func newOutscaleClientStub(t *testing.T, outscaleClient outscale.Client) NewOutscaleClientFunc {
+	t.Helper()
+
+	return func(_ context.Context, endpoint, region, accessKey, secretKey string) (outscale.Client, error) {
+		require.Empty(t, endpoint)
+		require.Equal(t, "eu-west-2", region)
+		require.Equal(t, "AK", accessKey)
+		require.Equal(t, "SK", secretKey)
+		return outscaleClient, nil
+	}
+}
+
+func outscaleAPINetPeeringNetworkingSpec() apiv1.NetworkingSpec {
+	return apiv1.NetworkingSpec{
+		Outscale: &apiv1.OutscaleSpec{
+			NetPeering: &apiv1.OutscaleNetPeeringSpec{
+				SourceNetID: "vpc-source",
+				AccountID:   "210987654321",
+				Region:      "eu-west-2",
+				CredentialsSecret: apiv1.OutscaleCredentialsSecretSpec{
+					Name: "outscale-credentials",
+				},
+			},
+		},
+	}
+}
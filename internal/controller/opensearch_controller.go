@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/controller/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	databaseusecases "github.com/Scalingo/scalingo-operator/internal/usecases/database"
+)
+
+// OpenSearchEngine is the database engine of the OpenSearch resources.
+type OpenSearchEngine struct{}
+
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=opensearches,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=opensearches/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=opensearches/finalizers,verbs=update
+
+func (OpenSearchEngine) DatabaseType() domain.DatabaseType {
+	return domain.DatabaseTypeOpenSearch
+}
+
+func (OpenSearchEngine) FinalizerName() string {
+	return helpers.OpenSearchFinalizerName
+}
+
+func (OpenSearchEngine) NewObject() DatabaseObject {
+	return &apiv1.OpenSearch{}
+}
+
+func (OpenSearchEngine) NewObjectList() client.ObjectList {
+	return &apiv1.OpenSearchList{}
+}
+
+func (OpenSearchEngine) ToDatabase(ctx context.Context, object DatabaseObject) (domain.Database, error) {
+	return adapters.OpenSearchToDatabase(ctx, *object.(*apiv1.OpenSearch))
+}
+
+// UpdateEngineStatus sets the URL of the Kibana or OpenSearch Dashboards of the database in the status. The URL
+// is read at each reconciliation of the provisioned database, and periodically.
+func (OpenSearchEngine) UpdateEngineStatus(ctx context.Context, dbManager databaseusecases.Manager, object DatabaseObject, db domain.Database) (bool, error) {
+	opensearch := object.(*apiv1.OpenSearch)
+
+	dashboardsURL, err := dbManager.GetDatabaseDashboardsURL(ctx, db)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, "get database dashboards url")
+	}
+	if opensearch.Status.DashboardsURL == dashboardsURL {
+		return false, nil
+	}
+	opensearch.Status.DashboardsURL = dashboardsURL
+	return true, nil
+}
@@ -18,352 +18,49 @@ package controller
 
 import (
 	"context"
-	"time"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
-	"github.com/Scalingo/go-utils/errors/v3"
 	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
 	"github.com/Scalingo/scalingo-operator/internal/controller/adapters"
 	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
-	"github.com/Scalingo/scalingo-operator/internal/controller/networking"
 	"github.com/Scalingo/scalingo-operator/internal/domain"
-	databasebase "github.com/Scalingo/scalingo-operator/internal/usecases/database/base"
 )
 
-// PostgreSQLReconciler reconciles a PostgreSQL object
-type PostgreSQLReconciler struct {
-	client.Client
-	Scheme *runtime.Scheme
-}
+// PostgreSQLEngine is the database engine of the PostgreSQL resources.
+type PostgreSQLEngine struct{}
 
 // +kubebuilder:rbac:groups=databases.scalingo.com,resources=postgresqls,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=databases.scalingo.com,resources=postgresqls/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=databases.scalingo.com,resources=postgresqls/finalizers,verbs=update
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=firewallrulesets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=scalingoprojects,verbs=get;list;watch
 
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=oks.dev,resources=netpeeringrequests,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=oks.dev,resources=netpeerings,verbs=get;list;delete
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.1/pkg/reconcile
-func (r *PostgreSQLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	log := logf.FromContext(ctx)
-
-	// Fetch the instance.
-	var postgresql apiv1.PostgreSQL
-	err := r.Get(ctx, req.NamespacedName, &postgresql)
-	if err != nil {
-		// Handle error, if it's not found, there's nothing to do.
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
-	// State information.
-	containsFinalizer := controllerutil.ContainsFinalizer(&postgresql, helpers.PostgreSQLFinalizerName)
-	hasRunningAnnotation := metav1.HasAnnotation(postgresql.ObjectMeta, helpers.DatabaseAnnotationIsRunning)
-	isDatabaseStatusInitialized := helpers.IsDatabaseInitialized(postgresql.Status.Conditions)
-
-	isDatabaseProvisioning := helpers.IsDatabaseProvisioning(postgresql.Status.Conditions)
-	isDatabaseAvailable := helpers.IsDatabaseAvailable(postgresql.Status.Conditions)
-	isDatabaseRunning := helpers.IsDatabaseRunning(postgresql.ObjectMeta)
-	isDatabaseDeletionRequested := helpers.IsDatabaseDeletionRequested(postgresql.ObjectMeta)
-
-	// Trigger variables.
-	var (
-		triggerUpdate       bool
-		triggerStatusUpdate bool
-		triggerRequeueLater time.Duration
-	)
-
-	// Initialization and resource updates.
-	// (no Scalingo client interaction)
-	//
-	// Initialize steps:
-	// 1/ add finalizer + requeue
-	// 2/ set initial Status.Condition + requeue
-	// 3/ set initial Annotations + requeue
-	//
-	// Note: the `requeue` prevents resource update conflicts.
-	switch {
-	case !containsFinalizer:
-		log.Info("Add finalizer to resource", "finalizer", helpers.PostgreSQLFinalizerName)
-
-		controllerutil.AddFinalizer(&postgresql, helpers.PostgreSQLFinalizerName)
-		err := r.Update(ctx, &postgresql)
-		if err != nil {
-			return ctrl.Result{}, errors.Wrap(ctx, err, "add resource finalizer")
-		}
-		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
-
-	case !isDatabaseStatusInitialized:
-		log.Info("Initialize resource status conditions")
-
-		helpers.SetDatabaseInitialStatus(&postgresql.Status.Conditions)
-		triggerStatusUpdate = true
-
-	case isDatabaseStatusInitialized && !hasRunningAnnotation:
-		log.Info("Initialize resource annotations")
-
-		helpers.SetDatabaseIsNotRunning(&postgresql.ObjectMeta)
-		triggerUpdate = true
-
-	case isDatabaseAvailable && !isDatabaseRunning:
-		// Update running annotation.
-		log.Info("Update database resource running annotation")
-
-		helpers.SetDatabaseIsRunning(&postgresql.ObjectMeta)
-		triggerUpdate = true
-	}
-
-	// Apply triggered resource updates with short delay requeue.
-	switch {
-	case triggerStatusUpdate:
-		err := r.Status().Update(ctx, &postgresql)
-		if err != nil {
-			return ctrl.Result{}, errors.Wrap(ctx, err, "update database resource status")
-		}
-		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
-
-	case triggerUpdate:
-		err := r.Update(ctx, &postgresql)
-		if err != nil {
-			return ctrl.Result{}, errors.Wrap(ctx, err, "update database resource")
-		}
-		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
-	}
-
-	// Read secret token.
-	secretManager := helpers.NewSecretManager(r.Client, &postgresql)
-
-	authSecret := domain.Secret{Namespace: req.Namespace, Name: postgresql.Spec.AuthSecret.Name, Key: postgresql.Spec.AuthSecret.Key}
-	log.Info("Get auth secret", "secret", authSecret)
-
-	apiToken, err := secretManager.GetSecret(ctx, authSecret)
-	if err != nil {
-		return ctrl.Result{}, errors.Wrap(ctx, err, "get auth secret")
-	}
-
-	// Create database manager.
-	dbManager, err := databasebase.NewManager(ctx, domain.DatabaseTypePostgreSQL, apiToken, postgresql.Spec.Region)
-	if err != nil {
-		return ctrl.Result{}, errors.Wrap(ctx, err, "create database manager")
-	}
-
-	// Requested/expected database resource.
-	expectedDB, err := adapters.PostgreSQLToDatabase(ctx, postgresql)
-	if err != nil {
-		return ctrl.Result{}, errors.Wrap(ctx, err, "bad custom resource format")
-	}
-
-	log.Info("Current state",
-		"database", postgresql.Status.ScalingoDatabaseID,
-		"deletion_requested", isDatabaseDeletionRequested,
-		"available", isDatabaseAvailable,
-		"provisioning", isDatabaseProvisioning,
-		"running", isDatabaseRunning)
-
-	isOutscaleOKSNetPeeringEnabled := postgresql.Spec.Networking.IsOutscaleOKSNetPeeringEnabled()
-	netPeeringReconciler := networking.NetPeeringReconciler{
-		Client: r.Client,
-		Scheme: r.Scheme,
-	}
-	netPeeringResource := networking.DatabaseResource{
-		Name:       postgresql.Name,
-		Namespace:  postgresql.Namespace,
-		Owner:      &postgresql,
-		DatabaseID: postgresql.Status.ScalingoDatabaseID,
-		Networking: postgresql.Spec.Networking,
-	}
-
-	// Create/update/delete database.
-	switch {
-	case isDatabaseDeletionRequested:
-		log.Info("Delete database")
-		shouldDeleteResources := true
-
-		if postgresql.Status.ScalingoDatabaseID == "" {
-			log.Info("Database provisioning requested but no database created yet, skip database deletion")
-		} else {
-			ok, err := dbManager.CheckDatabaseExists(ctx, postgresql.Status.ScalingoDatabaseID)
-			if err != nil {
-				return ctrl.Result{}, errors.Wrapf(ctx, err, "check database %s exists", postgresql.Status.ScalingoDatabaseID)
-			}
-			if !ok {
-				shouldDeleteResources = false
-				log.Info("Scalingo database not found, skip database deletion", "database", postgresql.Status.ScalingoDatabaseID)
-			}
-
-			if shouldDeleteResources {
-				if isOutscaleOKSNetPeeringEnabled {
-					err = netPeeringReconciler.DeleteNetPeerings(ctx, dbManager, netPeeringResource)
-					if err != nil {
-						return ctrl.Result{}, errors.Wrap(ctx, err, "delete net peering resources")
-					}
-				}
-
-				err = dbManager.DeleteDatabase(ctx, postgresql.Status.ScalingoDatabaseID)
-				if err != nil {
-					return ctrl.Result{}, errors.Wrapf(ctx, err, "delete database id %s", postgresql.Status.ScalingoDatabaseID)
-				}
-			}
-		}
-
-		controllerutil.RemoveFinalizer(&postgresql, helpers.PostgreSQLFinalizerName)
-		err = r.Update(ctx, &postgresql)
-		if err != nil {
-			return ctrl.Result{}, errors.Wrap(ctx, err, "remove resource finalizer")
-		}
-
-	case !isDatabaseAvailable && postgresql.Status.ScalingoDatabaseID == "":
-		log.Info("Create database")
-
-		newDB, err := dbManager.CreateDatabase(ctx, expectedDB)
-		if err != nil {
-			log.Error(err, "Create database", "database", expectedDB)
-			return ctrl.Result{}, errors.Wrapf(ctx, err, "create database %s", expectedDB.Name)
-		}
-
-		postgresql.Status.ScalingoDatabaseID = newDB.ID
-		helpers.SetDatabaseStatusProvisioning(&postgresql.Status.Conditions)
-		triggerStatusUpdate = true
-		triggerRequeueLater = helpers.RequeueLongDelay
-
-	case isDatabaseAvailable && !isDatabaseProvisioning && postgresql.Status.ScalingoDatabaseID != "":
-		log.Info("Update database")
-
-		dbStatus, err := dbManager.UpdateDatabase(ctx, postgresql.Status.ScalingoDatabaseID, expectedDB)
-		if err != nil {
-			log.Error(err, "Update database", "database", expectedDB)
-			return ctrl.Result{}, errors.Wrapf(ctx, err, "update database %s", expectedDB.Name)
-		}
-
-		if dbStatus == domain.DatabaseStatusProvisioning {
-			log.Info("Waiting for database being provisioned")
-			helpers.SetDatabaseStatusProvisioning(&postgresql.Status.Conditions)
-			triggerStatusUpdate = true
-		}
-
-	case isDatabaseProvisioning && postgresql.Status.ScalingoDatabaseID != "":
-		// Keep applying compatible updates (e.g firewall rules) while the database is
-		// available (created) and provisioning.
-		if isDatabaseAvailable {
-			_, err := dbManager.UpdateDatabase(ctx, postgresql.Status.ScalingoDatabaseID, expectedDB)
-			if err != nil {
-				log.Error(err, "Update database while provisioning", "database", expectedDB)
-				return ctrl.Result{}, errors.Wrapf(ctx, err, "update database %s while provisioning", expectedDB.Name)
-			}
-		}
-
-		// Wait for database creation/plan update completion.
-		currentDB, err := dbManager.GetDatabase(ctx, postgresql.Status.ScalingoDatabaseID)
-		if err != nil {
-			return ctrl.Result{}, errors.Wrapf(ctx, err, "get current database %s", postgresql.Status.ScalingoDatabaseID)
-		}
-
-		if currentDB.Status == domain.DatabaseStatusRunning {
-			log.Info("Database is provisioned")
-
-			helpers.SetDatabaseStatusProvisioned(&postgresql.Status.Conditions)
-			triggerStatusUpdate = true
-
-			// Write connection info in secret
-			dbURL, err := dbManager.GetDatabaseURL(ctx, currentDB)
-			if err != nil {
-				return ctrl.Result{}, errors.Wrap(ctx, err, "get database url")
-			}
-
-			connInfoSecret := domain.Secret{
-				Namespace: req.Namespace,
-				Name:      postgresql.Spec.ConnInfoSecretTarget.Name,
-				Key:       domain.ComposeConnectionURLName(postgresql.Spec.ConnInfoSecretTarget.Prefix, dbURL.Name),
-				Value:     dbURL.Value,
-			}
-			log.Info("Write connection info secret", "secret", connInfoSecret)
-
-			err = secretManager.SetSecret(ctx, connInfoSecret)
-			if err != nil {
-				return ctrl.Result{}, errors.Wrapf(ctx, err, "set secret %s", connInfoSecret.Key)
-			}
-
-			endpoints, err := dbManager.GetDatabaseEndpoints(ctx, currentDB.ID)
-			if err != nil {
-				return ctrl.Result{}, errors.Wrap(ctx, err, "get database endpoints")
-			}
-
-			for _, endpoint := range endpoints {
-				endpointURL, err := domain.ComposeEndpointConnectionURL(ctx, dbURL.Value, endpoint)
-				if err != nil {
-					return ctrl.Result{}, errors.Wrap(ctx, err, "compose endpoint connection url")
-				}
-
-				endpointConnInfoSecret := domain.Secret{
-					Namespace: req.Namespace,
-					Name:      postgresql.Spec.ConnInfoSecretTarget.Name,
-					Key:       domain.ComposeEndpointConnectionURLName(postgresql.Spec.ConnInfoSecretTarget.Prefix, dbURL.Name, endpoint.Type),
-					Value:     endpointURL,
-				}
-				log.Info("Write endpoint connection info secret", "secret", endpointConnInfoSecret)
-
-				err = secretManager.SetSecret(ctx, endpointConnInfoSecret)
-				if err != nil {
-					return ctrl.Result{}, errors.Wrapf(ctx, err, "set secret %s", endpointConnInfoSecret.Key)
-				}
-			}
-			triggerRequeueLater = helpers.RequeueShortDelay // Requeue for the is running annotation.
-
-		} else {
-			log.Info("Waiting for database being provisioned")
-			triggerRequeueLater = helpers.RequeueLongDelay
-		}
-	}
+func (PostgreSQLEngine) DatabaseType() domain.DatabaseType {
+	return domain.DatabaseTypePostgreSQL
+}
 
-	netPeeringResource.DatabaseID = postgresql.Status.ScalingoDatabaseID
-	netPeeringRequeue, err := netPeeringReconciler.Reconcile(
-		ctx,
-		dbManager,
-		netPeeringResource,
-		networking.DatabaseState{
-			DeletionRequested: isDatabaseDeletionRequested,
-			Available:         isDatabaseAvailable,
-			Provisioning:      isDatabaseProvisioning,
-		},
-	)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-	if netPeeringRequeue > 0 {
-		triggerRequeueLater = netPeeringRequeue
-	}
+func (PostgreSQLEngine) FinalizerName() string {
+	return helpers.PostgreSQLFinalizerName
+}
 
-	// Apply triggered resource updates and requeue later.
-	if triggerStatusUpdate {
-		log.Info("Update resource status", "statusConditions", postgresql.Status.Conditions)
-		err := r.Status().Update(ctx, &postgresql)
-		if err != nil {
-			return ctrl.Result{}, errors.Wrap(ctx, err, "update database resource status")
-		}
-	}
-	if triggerRequeueLater > 0 {
-		log.Info("Requeue after", "delay", triggerRequeueLater)
-		return ctrl.Result{RequeueAfter: triggerRequeueLater}, nil
-	}
+func (PostgreSQLEngine) NewObject() DatabaseObject {
+	return &apiv1.PostgreSQL{}
+}
 
-	log.Info("Ready")
-	return ctrl.Result{}, nil
+func (PostgreSQLEngine) NewObjectList() client.ObjectList {
+	return &apiv1.PostgreSQLList{}
 }
 
-// SetupWithManager sets up the controller with the Manager.
-func (r *PostgreSQLReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&apiv1.PostgreSQL{}).
-		Named("postgresql").
-		Complete(r)
+func (PostgreSQLEngine) ToDatabase(ctx context.Context, object DatabaseObject) (domain.Database, error) {
+	return adapters.PostgreSQLToDatabase(ctx, *object.(*apiv1.PostgreSQL))
 }
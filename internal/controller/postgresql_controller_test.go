@@ -24,13 +24,18 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	databaseusecases "github.com/Scalingo/scalingo-operator/internal/usecases/database"
+	"github.com/Scalingo/scalingo-operator/internal/usecases/database/databasemock"
 )
 
 var _ = Describe("PostgreSQL Controller", func() {
@@ -56,16 +61,18 @@ var _ = Describe("PostgreSQL Controller", func() {
 						Namespace: namespace,
 					},
 					Spec: apiv1.PostgreSQLSpec{
-						AuthSecret: apiv1.AuthSecretSpec{
-							Name: "scalingo-auth-secret",
-							Key:  "api_token",
-						},
-						ConnInfoSecretTarget: apiv1.SecretTargetSpec{
-							Name: "postgresql-conn-info",
+						DatabaseSpec: apiv1.DatabaseSpec{
+							AuthSecret: apiv1.AuthSecretSpec{
+								Name: "scalingo-auth-secret",
+								Key:  "api_token",
+							},
+							ConnInfoSecretTarget: apiv1.SecretTargetSpec{
+								Name: "postgresql-conn-info",
+							},
+							Name:   "my-postgresql-db",
+							Plan:   "postgresql-dr-enterprise-4096",
+							Region: "osc-st-fr1",
 						},
-						Name:   "my-postgresql-db",
-						Plan:   "postgresql-dr-enterprise-4096",
-						Region: "osc-st-fr1",
 					},
 				}
 				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
@@ -99,9 +106,10 @@ var _ = Describe("PostgreSQL Controller", func() {
 		// This ensures the spec fields validity, and the secret is read properly.
 		It("fails due to invalid token", func() {
 			By("Reconciling the created resource")
-			controllerReconciler := &PostgreSQLReconciler{
+			controllerReconciler := &DatabaseReconciler{
 				Client: k8sClient,
 				Scheme: k8sClient.Scheme(),
+				Engine: PostgreSQLEngine{},
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
@@ -113,4 +121,146 @@ var _ = Describe("PostgreSQL Controller", func() {
 			Expect(err).To(MatchError(errUnauthorizedFullMessage))
 		})
 	})
+
+	Context("When updating the application of an addon database", func() {
+		const namespace = "default"
+
+		ctx := context.Background()
+
+		newPostgreSQL := func(name, appID string) *apiv1.PostgreSQL {
+			return &apiv1.PostgreSQL{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: apiv1.PostgreSQLSpec{
+					DatabaseSpec: apiv1.DatabaseSpec{
+						AuthSecret: apiv1.AuthSecretSpec{
+							Name: "scalingo-auth-secret",
+							Key:  "api_token",
+						},
+						ConnInfoSecretTarget: apiv1.SecretTargetSpec{
+							Name: "postgresql-conn-info",
+						},
+						Networking: apiv1.NetworkingSpec{
+							InternetAccess: apiv1.InternetAccessSpec{Enabled: true},
+						},
+						Name:   "my-postgresql-db",
+						Plan:   "postgresql-starter-512",
+						Region: "osc-st-fr1",
+						AppID:  appID,
+					},
+				},
+			}
+		}
+
+		DescribeTable("validates the appID transition",
+			func(name, appID, updatedAppID string, isAccepted bool) {
+				resource := newPostgreSQL(name, appID)
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+				})
+
+				resource.Spec.AppID = updatedAppID
+				resource.Spec.Plan = "postgresql-starter-1024"
+				err := k8sClient.Update(ctx, resource)
+				if isAccepted {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("appID is immutable"))
+				}
+			},
+			Entry("accepts an unchanged appID", "appid-unchanged", "my-app", "my-app", true),
+			Entry("accepts a still unset appID", "appid-unset", "", "", true),
+			Entry("rejects a changed appID", "appid-changed", "my-app", "other-app", false),
+			Entry("rejects an added appID", "appid-added", "", "my-app", false),
+			Entry("rejects a removed appID", "appid-removed", "my-app", "", false),
+		)
+	})
+
+	Context("When deleting a database whose firewall rule set is already deleted", func() {
+		const namespace = "default"
+
+		ctx := context.Background()
+
+		It("removes the finalizer", func() {
+			By("creating the database referencing a firewall rule set")
+			authSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "scalingo-auth-secret-rule-set",
+					Namespace: namespace,
+				},
+				Type: corev1.SecretTypeOpaque,
+				StringData: map[string]string{
+					"api_token": "s3cr3t",
+				},
+			}
+			Expect(k8sClient.Create(ctx, authSecret)).To(Succeed())
+
+			ruleSet := &apiv1.FirewallRuleSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "offices",
+					Namespace: namespace,
+				},
+				Spec: apiv1.FirewallRuleSetSpec{
+					Rules: []apiv1.FirewallRuleSpec{{Type: "custom_range", CIDR: "198.51.100.0/24", Label: "Paris office"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ruleSet)).To(Succeed())
+
+			resource := &apiv1.PostgreSQL{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rule-set-deleted",
+					Namespace: namespace,
+				},
+				Spec: apiv1.PostgreSQLSpec{
+					DatabaseSpec: apiv1.DatabaseSpec{
+						AuthSecret: apiv1.AuthSecretSpec{
+							Name: "scalingo-auth-secret-rule-set",
+							Key:  "api_token",
+						},
+						ConnInfoSecretTarget: apiv1.SecretTargetSpec{
+							Name: "postgresql-conn-info",
+						},
+						Networking: apiv1.NetworkingSpec{
+							InternetAccess: apiv1.InternetAccessSpec{Enabled: true},
+							Firewall:       &apiv1.FirewallSpec{RuleSets: []string{"offices"}},
+						},
+						Name:   "my-postgresql-db",
+						Plan:   "postgresql-starter-512",
+						Region: "osc-st-fr1",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+
+			// The database is not created on Scalingo, the manager is not expected to be called.
+			controllerReconciler := &DatabaseReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+				Engine: PostgreSQLEngine{},
+				NewDatabaseManager: func(context.Context, domain.DatabaseType, string, string, string) (databaseusecases.Manager, error) {
+					return databasemock.NewMockManager(gomock.NewController(GinkgoT())), nil
+				},
+			}
+			request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(resource)}
+
+			_, err := controllerReconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("deleting the firewall rule set, then the database")
+			Expect(k8sClient.Delete(ctx, ruleSet)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				_, err := controllerReconciler.Reconcile(ctx, request)
+				g.Expect(err).NotTo(HaveOccurred())
+
+				err = k8sClient.Get(ctx, request.NamespacedName, &apiv1.PostgreSQL{})
+				g.Expect(errors.IsNotFound(err)).To(BeTrue())
+			}).Should(Succeed())
+		})
+	})
 })
@@ -0,0 +1,244 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/controller/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	appbase "github.com/Scalingo/scalingo-operator/internal/usecases/app/base"
+)
+
+// ScalingoAppReconciler reconciles the ScalingoApp resources with the Scalingo applications.
+type ScalingoAppReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=scalingoapps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=scalingoapps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=scalingoapps/finalizers,verbs=update
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=scalingoprojects,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile creates the Scalingo application of the resource, keeps its stack, project, environment and
+// containers up to date, and deletes it with the resource.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.1/pkg/reconcile
+func (r *ScalingoAppReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	app := &apiv1.ScalingoApp{}
+	err := r.Get(ctx, req.NamespacedName, app)
+	if err != nil {
+		// Handle error, if it's not found, there's nothing to do.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	status := &app.Status
+
+	containsFinalizer := controllerutil.ContainsFinalizer(app, helpers.ScalingoAppFinalizerName)
+	isDeletionRequested := !app.GetDeletionTimestamp().IsZero()
+
+	if !containsFinalizer {
+		if isDeletionRequested {
+			return ctrl.Result{}, nil
+		}
+		log.Info("Add finalizer to resource", "finalizer", helpers.ScalingoAppFinalizerName)
+
+		controllerutil.AddFinalizer(app, helpers.ScalingoAppFinalizerName)
+		err := r.Update(ctx, app)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "add resource finalizer")
+		}
+		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
+	}
+
+	// Read secret token.
+	secretManager := helpers.NewSecretManager(r.Client, app)
+
+	authSecret := domain.Secret{Namespace: req.Namespace, Name: app.Spec.AuthSecret.Name, Key: app.Spec.AuthSecret.Key}
+	log.Info("Get auth secret", "secret", authSecret)
+
+	apiToken, err := secretManager.GetSecret(ctx, authSecret)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(ctx, err, "get auth secret")
+	}
+
+	appManager, err := appbase.NewManager(ctx, apiToken, app.Spec.Region)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(ctx, err, "create app manager")
+	}
+
+	if isDeletionRequested {
+		if status.ScalingoAppID == "" {
+			log.Info("No application created yet, skip application deletion")
+		} else {
+			log.Info("Delete application", "app", status.ScalingoAppID)
+
+			err = appManager.DeleteApp(ctx, adapters.ScalingoAppToApp(*app, nil))
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(ctx, err, "delete app id %s", status.ScalingoAppID)
+			}
+		}
+
+		controllerutil.RemoveFinalizer(app, helpers.ScalingoAppFinalizerName)
+		err = r.Update(ctx, app)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "remove resource finalizer")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	variables, err := helpers.ResolveAppVariables(ctx, secretManager, req.Namespace, app.Spec.Env)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(ctx, err, "resolve app variables")
+	}
+
+	expectedApp := adapters.ScalingoAppToApp(*app, variables)
+	if app.Spec.ProjectRef != nil {
+		projectResolver := helpers.ProjectResolver{Client: r.Client}
+		expectedApp.ProjectID, err = projectResolver.ResolveProjectID(ctx, req.Namespace, *app.Spec.ProjectRef)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "resolve project reference")
+		}
+	}
+
+	if status.ScalingoAppID == "" {
+		log.Info("Create application", "name", expectedApp.Name)
+
+		newApp, err := appManager.CreateApp(ctx, expectedApp)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(ctx, err, "create app %s", expectedApp.Name)
+		}
+
+		status.ScalingoAppID = newApp.ID
+		status.URL = newApp.URL
+		status.ProjectID = newApp.ProjectID
+		helpers.SetAppAvailableStatus(&status.Conditions, true, app.GetGeneration())
+		err = r.Status().Update(ctx, app)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "update app resource status")
+		}
+		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
+	}
+
+	removedVariableNames := helpers.RemovedAppEnvNames(status.ManagedEnv, app.Spec.Env)
+	currentApp, err := appManager.UpdateApp(ctx, status.ScalingoAppID, expectedApp, removedVariableNames)
+	if errors.Is(err, domain.ErrApplicationNotFound) {
+		log.Error(err, "Application deleted outside of the operator", "app", status.ScalingoAppID)
+
+		if helpers.SetAppAvailableStatus(&status.Conditions, false, app.GetGeneration()) {
+			statusErr := r.Status().Update(ctx, app)
+			if statusErr != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, statusErr, "update app resource status")
+			}
+		}
+		return ctrl.Result{}, errors.Wrapf(ctx, err, "update app %s", status.ScalingoAppID)
+	} else if err != nil {
+		return ctrl.Result{}, errors.Wrapf(ctx, err, "update app %s", status.ScalingoAppID)
+	}
+
+	managedEnv := helpers.AppEnvNames(app.Spec.Env)
+	isConditionChanged := helpers.SetAppAvailableStatus(&status.Conditions, true, app.GetGeneration())
+
+	// The container types missing from the formation are scaled once deployed, checked periodically.
+	missingContainerTypes := helpers.MissingAppContainerTypes(app.Spec.Containers, currentApp.Containers)
+	if len(missingContainerTypes) > 0 {
+		log.Info("Container types not in the application formation", "containerTypes", missingContainerTypes)
+	}
+	if helpers.SetAppContainersScaledStatus(&status.Conditions, missingContainerTypes, app.GetGeneration()) {
+		isConditionChanged = true
+	}
+	if isConditionChanged || currentApp.URL != status.URL || currentApp.ProjectID != status.ProjectID || !slices.Equal(managedEnv, status.ManagedEnv) {
+		log.Info("Update resource status", "url", currentApp.URL, "project", currentApp.ProjectID)
+
+		status.URL = currentApp.URL
+		status.ProjectID = currentApp.ProjectID
+		status.ManagedEnv = managedEnv
+		err = r.Status().Update(ctx, app)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "update app resource status")
+		}
+	}
+
+	if len(missingContainerTypes) > 0 {
+		return ctrl.Result{RequeueAfter: helpers.RequeueRefreshDelay}, nil
+	}
+
+	log.Info("Ready")
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScalingoAppReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apiv1.ScalingoApp{}).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.secretRequests),
+		).
+		Watches(
+			&apiv1.ScalingoProject{},
+			handler.EnqueueRequestsFromMapFunc(r.scalingoProjectRequests),
+		).
+		Named("scalingoapp").
+		Complete(r)
+}
+
+// secretRequests returns the resources whose environment reads the given secret.
+func (r *ScalingoAppReconciler) secretRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.appRequests(ctx, obj, helpers.AppUsesSecret)
+}
+
+// scalingoProjectRequests returns the resources referencing the given Scalingo project.
+func (r *ScalingoAppReconciler) scalingoProjectRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.appRequests(ctx, obj, helpers.AppUsesScalingoProject)
+}
+
+func (r *ScalingoAppReconciler) appRequests(ctx context.Context, obj client.Object, uses func(apiv1.ScalingoApp, client.Object) bool) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	var apps apiv1.ScalingoAppList
+	err := r.List(ctx, &apps, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		log.Error(err, "List application resources")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, app := range apps.Items {
+		if uses(app, obj) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&app)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,80 @@
+//go:build integration
+// +build integration
+
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+)
+
+var _ = Describe("ScalingoApp Controller", func() {
+	Context("When updating the name of an application", func() {
+		const namespace = "default"
+
+		ctx := context.Background()
+
+		newScalingoApp := func(resourceName, name string) *apiv1.ScalingoApp {
+			return &apiv1.ScalingoApp{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: namespace,
+				},
+				Spec: apiv1.ScalingoAppSpec{
+					AuthSecret: apiv1.AuthSecretSpec{
+						Name: "scalingo-auth-secret",
+						Key:  "api_token",
+					},
+					Name:   name,
+					Region: "osc-fr1",
+				},
+			}
+		}
+
+		DescribeTable("validates the name transition",
+			func(resourceName, name, updatedName string, isAccepted bool) {
+				resource := newScalingoApp(resourceName, name)
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+				})
+
+				resource.Spec.Name = updatedName
+				resource.Spec.Stack = "scalingo-24"
+				err := k8sClient.Update(ctx, resource)
+				if isAccepted {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("name is immutable"))
+				}
+			},
+			Entry("accepts an unchanged name", "name-unchanged", "my-app", "my-app", true),
+			Entry("accepts a still unset name", "name-unset", "", "", true),
+			Entry("rejects a changed name", "name-changed", "my-app", "other-app", false),
+			Entry("rejects an added name", "name-added", "", "my-app", false),
+			Entry("rejects a removed name", "name-removed", "my-app", "", false),
+		)
+	})
+})
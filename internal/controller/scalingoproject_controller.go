@@ -0,0 +1,191 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	apiv1 "github.com/Scalingo/scalingo-operator/api/v1"
+	"github.com/Scalingo/scalingo-operator/internal/controller/adapters"
+	"github.com/Scalingo/scalingo-operator/internal/controller/helpers"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	projectbase "github.com/Scalingo/scalingo-operator/internal/usecases/project/base"
+)
+
+// ScalingoProjectReconciler reconciles the ScalingoProject resources with the Scalingo projects.
+type ScalingoProjectReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=scalingoprojects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=scalingoprojects/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=databases.scalingo.com,resources=scalingoprojects/finalizers,verbs=update
+
+// Reconcile creates the Scalingo project of the resource, keeps its name and default flag up to date, reports
+// its private network, and deletes it with the resource.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.1/pkg/reconcile
+func (r *ScalingoProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	project := &apiv1.ScalingoProject{}
+	err := r.Get(ctx, req.NamespacedName, project)
+	if err != nil {
+		// Handle error, if it's not found, there's nothing to do.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	status := &project.Status
+
+	containsFinalizer := controllerutil.ContainsFinalizer(project, helpers.ScalingoProjectFinalizerName)
+	isDeletionRequested := !project.GetDeletionTimestamp().IsZero()
+
+	if !containsFinalizer {
+		if isDeletionRequested {
+			return ctrl.Result{}, nil
+		}
+		log.Info("Add finalizer to resource", "finalizer", helpers.ScalingoProjectFinalizerName)
+
+		controllerutil.AddFinalizer(project, helpers.ScalingoProjectFinalizerName)
+		err := r.Update(ctx, project)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "add resource finalizer")
+		}
+		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
+	}
+
+	// Read secret token.
+	secretManager := helpers.NewSecretManager(r.Client, project)
+
+	authSecret := domain.Secret{Namespace: req.Namespace, Name: project.Spec.AuthSecret.Name, Key: project.Spec.AuthSecret.Key}
+	log.Info("Get auth secret", "secret", authSecret)
+
+	apiToken, err := secretManager.GetSecret(ctx, authSecret)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(ctx, err, "get auth secret")
+	}
+
+	projectManager, err := projectbase.NewManager(ctx, apiToken, project.Spec.Region)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(ctx, err, "create project manager")
+	}
+
+	expectedProject := adapters.ScalingoProjectToProject(*project)
+
+	switch {
+	case isDeletionRequested:
+		if status.ScalingoProjectID == "" {
+			log.Info("No project created yet, skip project deletion")
+		} else {
+			// The deletion fails while the project still has resources, e.g. databases: it is retried until
+			// they are deleted.
+			log.Info("Delete project", "project", status.ScalingoProjectID)
+
+			err = projectManager.DeleteProject(ctx, status.ScalingoProjectID)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(ctx, err, "delete project id %s", status.ScalingoProjectID)
+			}
+		}
+
+		controllerutil.RemoveFinalizer(project, helpers.ScalingoProjectFinalizerName)
+		err = r.Update(ctx, project)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "remove resource finalizer")
+		}
+		return ctrl.Result{}, nil
+
+	case status.ScalingoProjectID == "":
+		log.Info("Create project", "name", expectedProject.Name)
+
+		newProject, err := projectManager.CreateProject(ctx, expectedProject)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(ctx, err, "create project %s", expectedProject.Name)
+		}
+
+		status.ScalingoProjectID = newProject.ID
+		helpers.SetProjectAvailableStatus(&status.Conditions, true, project.GetGeneration())
+		err = r.Status().Update(ctx, project)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "update project resource status")
+		}
+		return ctrl.Result{RequeueAfter: helpers.RequeueShortDelay}, nil
+	}
+
+	currentProject, err := projectManager.UpdateProject(ctx, status.ScalingoProjectID, expectedProject)
+	if errors.Is(err, domain.ErrProjectNotFound) {
+		log.Error(err, "Project deleted outside of the operator", "project", status.ScalingoProjectID)
+
+		if helpers.SetProjectAvailableStatus(&status.Conditions, false, project.GetGeneration()) {
+			statusErr := r.Status().Update(ctx, project)
+			if statusErr != nil {
+				return ctrl.Result{}, errors.Wrap(ctx, statusErr, "update project resource status")
+			}
+		}
+		return ctrl.Result{}, errors.Wrapf(ctx, err, "update project %s", status.ScalingoProjectID)
+	} else if err != nil {
+		return ctrl.Result{}, errors.Wrapf(ctx, err, "update project %s", status.ScalingoProjectID)
+	}
+
+	// The private network is created with the first private resource of the project, e.g. a database with an
+	// IP range.
+	privateNetwork := adapters.ToProjectPrivateNetworkStatus(currentProject.PrivateNetwork)
+	isWaitingForPrivateNetwork := meta.IsStatusConditionFalse(status.Conditions, helpers.ProjectStatusConditionPrivateNetworkReady)
+
+	isConditionChanged := helpers.SetProjectAvailableStatus(&status.Conditions, true, project.GetGeneration())
+	if helpers.SetProjectPrivateNetworkReadyStatus(&status.Conditions, privateNetwork != nil, project.GetGeneration()) {
+		isConditionChanged = true
+	}
+	if isConditionChanged || !equality.Semantic.DeepEqual(privateNetwork, status.PrivateNetwork) {
+		log.Info("Update resource status", "privateNetwork", privateNetwork)
+
+		status.PrivateNetwork = privateNetwork
+		err = r.Status().Update(ctx, project)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(ctx, err, "update project resource status")
+		}
+	}
+
+	// Poll the private network less often once the project has been waiting for it, as it may never be created.
+	if privateNetwork == nil {
+		log.Info("Waiting for project private network")
+		if isWaitingForPrivateNetwork {
+			return ctrl.Result{RequeueAfter: helpers.RequeueRefreshDelay}, nil
+		}
+		return ctrl.Result{RequeueAfter: helpers.RequeueLongDelay}, nil
+	}
+
+	log.Info("Ready")
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScalingoProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apiv1.ScalingoProject{}).
+		Named("scalingoproject").
+		Complete(r)
+}
@@ -0,0 +1,38 @@
+package domain
+
+type App struct {
+	ID        string
+	Name      string
+	ProjectID string
+	URL       string
+
+	// Stack is the name or the ID of the stack of the application, empty to keep the current stack.
+	Stack string
+
+	// Variables lists the environment variables of the application. The variables missing from the expected
+	// application are left as is.
+	Variables []AppVariable
+
+	// Containers lists the container types of the application. The container types missing from the expected
+	// application are left as is.
+	Containers []AppContainer
+}
+
+type AppVariable struct {
+	ID    string
+	Name  string
+	Value string
+}
+
+type AppContainer struct {
+	Name   string
+	Amount int
+
+	// Size is the container size name, e.g. "M", empty to keep the current size.
+	Size string
+}
+
+type Stack struct {
+	ID   string
+	Name string
+}
@@ -9,8 +9,21 @@ type Database struct {
 	Technology string
 	Status     DatabaseStatus
 	Plan       string
+	Version    string
 	ProjectID  string
 	IPRange    string
 
+	// MongoReplSetName is the name of the MongoDB replica set, whose members are the db-node instances.
+	MongoReplSetName string
+	Instances        []DatabaseInstance
+
 	FireWallRules []FirewallRule
+
+	// IsFirewallExclusive deletes the firewall rules which are not expected, even those not created by the
+	// operator.
+	IsFirewallExclusive bool
+
+	// Features lists the database features by name, with whether they are enabled. The features missing from
+	// the expected database are left as is.
+	Features map[string]bool
 }
@@ -0,0 +1,15 @@
+package domain
+
+type DatabaseInstanceType string
+
+const (
+	// DatabaseInstanceTypeDBNode instances hold the data, e.g. the members of a MongoDB replica set.
+	DatabaseInstanceTypeDBNode DatabaseInstanceType = "db-node"
+)
+
+// DatabaseInstance is one of the nodes running the database.
+type DatabaseInstance struct {
+	Hostname string
+	Port     int
+	Type     DatabaseInstanceType
+}
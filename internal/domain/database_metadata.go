@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	metadataDatabaseIDKeySuffix        = "_DATABASE_ID"
+	metadataRegionKeySuffix            = "_REGION"
+	metadataPlanKeySuffix              = "_PLAN"
+	metadataVersionKeySuffix           = "_VERSION"
+	metadataHostKeySuffix              = "_HOST"
+	metadataPortKeySuffix              = "_PORT"
+	metadataOutscaleNetPeeringIDSuffix = "_OUTSCALE_NET_PEERING_ID"
+)
+
+// DatabaseMetadata holds the non-sensitive information of a database.
+type DatabaseMetadata struct {
+	// Name is the default name of the metadata keys, e.g. "SCALINGO_POSTGRESQL".
+	Name                  string
+	DatabaseID            string
+	Region                string
+	Plan                  string
+	Version               string
+	Endpoints             []DatabaseEndpoint
+	OutscaleNetPeeringIDs []string
+}
+
+// Data returns the metadata as key/values, the keys being prefixed by `prefix` or the default metadata name.
+func (m DatabaseMetadata) Data(prefix string) map[string]string {
+	if prefix == "" {
+		prefix = m.Name
+	}
+
+	data := map[string]string{
+		prefix + metadataDatabaseIDKeySuffix: m.DatabaseID,
+		prefix + metadataRegionKeySuffix:     m.Region,
+		prefix + metadataPlanKeySuffix:       m.Plan,
+		prefix + metadataVersionKeySuffix:    m.Version,
+	}
+	for _, endpoint := range m.Endpoints {
+		endpointPrefix := prefix + "_" + endpointTypeKeyName(endpoint.Type)
+		data[endpointPrefix+metadataHostKeySuffix] = endpoint.Hostname
+		data[endpointPrefix+metadataPortKeySuffix] = strconv.Itoa(endpoint.Port)
+	}
+	if len(m.OutscaleNetPeeringIDs) > 0 {
+		data[prefix+metadataOutscaleNetPeeringIDSuffix] = strings.Join(m.OutscaleNetPeeringIDs, ",")
+	}
+
+	// Drop unknown values rather than publishing empty keys.
+	for key, value := range data {
+		if value == "" {
+			delete(data, key)
+		}
+	}
+	return data
+}
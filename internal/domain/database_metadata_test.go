@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseMetadata_Data(t *testing.T) {
+	metadata := DatabaseMetadata{
+		Name:       "SCALINGO_POSTGRESQL",
+		DatabaseID: "db-123",
+		Region:     "osc-fr1",
+		Plan:       "postgresql-dr-enterprise-4096",
+		Version:    "17.4.0-1",
+		Endpoints: []DatabaseEndpoint{
+			{Hostname: "public.example.test", Port: 30000, Type: DatabaseEndpointTypePublicRW},
+			{Hostname: "10.0.0.12", Port: 5432, Type: DatabaseEndpointTypePrivatePeeringRW},
+		},
+		OutscaleNetPeeringIDs: []string{"pcx-1234", "pcx-5678"},
+	}
+
+	t.Run("uses default name as prefix when no prefix is set", func(t *testing.T) {
+		require.Equal(t, map[string]string{
+			"SCALINGO_POSTGRESQL_DATABASE_ID":             "db-123",
+			"SCALINGO_POSTGRESQL_REGION":                  "osc-fr1",
+			"SCALINGO_POSTGRESQL_PLAN":                    "postgresql-dr-enterprise-4096",
+			"SCALINGO_POSTGRESQL_VERSION":                 "17.4.0-1",
+			"SCALINGO_POSTGRESQL_PUBLIC_RW_HOST":          "public.example.test",
+			"SCALINGO_POSTGRESQL_PUBLIC_RW_PORT":          "30000",
+			"SCALINGO_POSTGRESQL_PRIVATE_PEERING_RW_HOST": "10.0.0.12",
+			"SCALINGO_POSTGRESQL_PRIVATE_PEERING_RW_PORT": "5432",
+			"SCALINGO_POSTGRESQL_OUTSCALE_NET_PEERING_ID": "pcx-1234,pcx-5678",
+		}, metadata.Data(""))
+	})
+
+	t.Run("uses the configured prefix and skips empty values", func(t *testing.T) {
+		require.Equal(t, map[string]string{
+			"PG_DATABASE_ID": "db-123",
+			"PG_REGION":      "osc-fr1",
+		}, DatabaseMetadata{DatabaseID: "db-123", Region: "osc-fr1"}.Data("PG"))
+	})
+}
@@ -21,7 +21,42 @@ type DatabaseNetworkConfiguration struct {
 	IPRange           string
 }
 
+type DatabaseNetPeeringStatus string
+
+const (
+	DatabaseNetPeeringStatusActive  DatabaseNetPeeringStatus = "active"
+	DatabaseNetPeeringStatusDeleted DatabaseNetPeeringStatus = "deleted"
+)
+
 type DatabaseNetPeering struct {
 	ID                   string
 	OutscaleNetPeeringID string
+	Status               DatabaseNetPeeringStatus
+}
+
+func (p DatabaseNetPeering) IsActive() bool {
+	return p.Status == DatabaseNetPeeringStatusActive
+}
+
+type OutscaleNetPeeringState string
+
+const (
+	OutscaleNetPeeringStatePendingAcceptance OutscaleNetPeeringState = "pending-acceptance"
+	OutscaleNetPeeringStateActive            OutscaleNetPeeringState = "active"
+)
+
+// OutscaleNetPeering is a peering between the Net of the cluster (source) and the Net of the database
+// (accepter).
+type OutscaleNetPeering struct {
+	ID                string
+	State             OutscaleNetPeeringState
+	SourceNetID       string
+	SourceAccountID   string
+	AccepterNetID     string
+	AccepterAccountID string
+}
+
+// IsUsable returns whether the net peering is active, or waits for the accepter to accept it.
+func (p OutscaleNetPeering) IsUsable() bool {
+	return p.State == OutscaleNetPeeringStatePendingAcceptance || p.State == OutscaleNetPeeringStateActive
 }
@@ -6,13 +6,34 @@ type DatabaseType string
 
 const (
 	DatabaseTypePostgreSQL DatabaseType = "postgresql"
+	DatabaseTypeMySQL      DatabaseType = "mysql"
+	DatabaseTypeRedis      DatabaseType = "redis"
+	DatabaseTypeMongoDB    DatabaseType = "mongodb"
+	DatabaseTypeOpenSearch DatabaseType = "opensearch"
 )
 
 func (t DatabaseType) Validate() error {
 	switch t {
-	case DatabaseTypePostgreSQL:
+	case DatabaseTypePostgreSQL, DatabaseTypeMySQL, DatabaseTypeRedis, DatabaseTypeMongoDB, DatabaseTypeOpenSearch:
 		return nil
 	default:
 		return fmt.Errorf("invalid database type: %s", t)
 	}
 }
+
+// HasVerifyFullConnectionURL returns whether the database connection URLs accept the `sslmode=verify-full`
+// parameter.
+func (t DatabaseType) HasVerifyFullConnectionURL() bool {
+	return t == DatabaseTypePostgreSQL
+}
+
+// HasTLSConnectionURL returns whether the database connection URLs have a `rediss://` variant requiring TLS.
+func (t DatabaseType) HasTLSConnectionURL() bool {
+	return t == DatabaseTypeRedis
+}
+
+// HasBasicAuthCredentials returns whether the database is reached over HTTPS with the basic authentication
+// credentials of its connection URL.
+func (t DatabaseType) HasBasicAuthCredentials() bool {
+	return t == DatabaseTypeOpenSearch
+}
@@ -9,6 +9,10 @@ import (
 func TestDatabaseType_Validate(t *testing.T) {
 	t.Run("it successfully validates type", func(t *testing.T) {
 		require.NoError(t, DatabaseTypePostgreSQL.Validate())
+		require.NoError(t, DatabaseTypeMySQL.Validate())
+		require.NoError(t, DatabaseTypeRedis.Validate())
+		require.NoError(t, DatabaseTypeMongoDB.Validate())
+		require.NoError(t, DatabaseTypeOpenSearch.Validate())
 	})
 
 	t.Run("it returns error", func(t *testing.T) {
@@ -16,3 +20,33 @@ func TestDatabaseType_Validate(t *testing.T) {
 		require.ErrorContains(t, DatabaseType("whatever").Validate(), "invalid database type")
 	})
 }
+
+func TestDatabaseType_HasVerifyFullConnectionURL(t *testing.T) {
+	t.Run("it is supported by PostgreSQL", func(t *testing.T) {
+		require.True(t, DatabaseTypePostgreSQL.HasVerifyFullConnectionURL())
+	})
+
+	t.Run("it is not supported by MySQL", func(t *testing.T) {
+		require.False(t, DatabaseTypeMySQL.HasVerifyFullConnectionURL())
+	})
+}
+
+func TestDatabaseType_HasTLSConnectionURL(t *testing.T) {
+	t.Run("it is supported by Redis", func(t *testing.T) {
+		require.True(t, DatabaseTypeRedis.HasTLSConnectionURL())
+	})
+
+	t.Run("it is not supported by PostgreSQL", func(t *testing.T) {
+		require.False(t, DatabaseTypePostgreSQL.HasTLSConnectionURL())
+	})
+}
+
+func TestDatabaseType_HasBasicAuthCredentials(t *testing.T) {
+	t.Run("it is supported by OpenSearch", func(t *testing.T) {
+		require.True(t, DatabaseTypeOpenSearch.HasBasicAuthCredentials())
+	})
+
+	t.Run("it is not supported by PostgreSQL", func(t *testing.T) {
+		require.False(t, DatabaseTypePostgreSQL.HasBasicAuthCredentials())
+	})
+}
@@ -5,5 +5,15 @@ import "errors"
 var (
 	ErrNotImplemented   = errors.New("not implemented")
 	ErrDatabaseNotFound = errors.New("database not found")
-	ErrNothingToBeDone  = errors.New("nothing to be done")
+
+	ErrDatabaseProjectMoveRejected = errors.New("database project move rejected")
+	ErrNothingToBeDone             = errors.New("nothing to be done")
+
+	ErrApplicationNotFound         = errors.New("application not found")
+	ErrApplicationVariableNotFound = errors.New("application variable not found")
+
+	ErrProjectNotFound               = errors.New("project not found")
+	ErrProjectPrivateNetworkNotFound = errors.New("project private network not found")
+
+	ErrFirewallManagedRangeNotFound = errors.New("firewall managed range not found")
 )
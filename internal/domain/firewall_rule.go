@@ -1,8 +1,13 @@
 package domain
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -11,19 +16,40 @@ type FirewallRuleType string
 const (
 	FirewallRuleTypeManagedRange FirewallRuleType = "managed_range"
 	FirewallRuleTypeCustomRange  FirewallRuleType = "custom_range"
+
+	// FirewallRuleTypeClusterEgress is resolved to custom ranges from the cluster egress IPs, and is never
+	// sent to Scalingo.
+	FirewallRuleTypeClusterEgress FirewallRuleType = "cluster_egress"
 )
 
+// FirewallRuleManagedByOperator marks the firewall rules created by the operator.
+const FirewallRuleManagedByOperator = "scalingo-operator"
+
 type FirewallRule struct {
 	ID      string
 	Type    FirewallRuleType
 	CIDR    string
 	Label   string
 	RangeID string
+
+	// RangeName references the managed range by name, and is resolved to the RangeID before being sent to
+	// Scalingo.
+	RangeName string
+
+	// ManagedBy is the owner of the rule, e.g. FirewallRuleManagedByOperator. It is empty for the rules added
+	// from the dashboard or the API.
+	ManagedBy string
+}
+
+// FirewallManagedRange is an IP range managed by Scalingo, e.g. the egress IPs of a region applications.
+type FirewallManagedRange struct {
+	ID   string
+	Name string
 }
 
 func (t FirewallRuleType) Validate() error {
 	switch t {
-	case FirewallRuleTypeManagedRange, FirewallRuleTypeCustomRange:
+	case FirewallRuleTypeManagedRange, FirewallRuleTypeCustomRange, FirewallRuleTypeClusterEgress:
 		return nil
 	default:
 		return fmt.Errorf("invalid firewall rule type: %s", t)
@@ -47,17 +73,54 @@ func (r FirewallRule) Validate() error {
 
 	switch r.Type {
 	case FirewallRuleTypeManagedRange:
-		if r.RangeID == "" {
-			return errors.New("missing range_id")
+		if r.RangeID == "" && r.RangeName == "" {
+			return errors.New("missing range_id or range_name")
+		}
+		if r.RangeID != "" && r.RangeName != "" {
+			return errors.New("range_id and range_name are mutually exclusive")
 		}
 	case FirewallRuleTypeCustomRange:
 		if r.CIDR == "" {
 			return errors.New("missing cidr")
 		}
+		_, err := NormalizeCIDR(r.CIDR)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// NormalizeCIDR validates an IPv4 or IPv6 range in CIDR notation and returns its canonical form, i.e. the
+// network address with the host bits cleared, e.g. "10.0.0.1/8" becomes "10.0.0.0/8".
+func NormalizeCIDR(cidr string) (string, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", fmt.Errorf("invalid cidr %q: %w", cidr, err)
+	}
+	return prefix.Masked().String(), nil
+}
+
+// Normalize returns the rule with its CIDR in canonical form, so that it compares equal to the same range
+// written differently.
+func (r FirewallRule) Normalize() (FirewallRule, error) {
+	if r.CIDR == "" {
+		return r, nil
+	}
+
+	cidr, err := NormalizeCIDR(r.CIDR)
+	if err != nil {
+		return FirewallRule{}, err
+	}
+	r.CIDR = cidr
+	return r, nil
+}
+
+// IsManagedByOperator returns whether the rule was created by the operator.
+func (r FirewallRule) IsManagedByOperator() bool {
+	return r.ManagedBy == FirewallRuleManagedByOperator
+}
+
 // CompareFirewallRules returns FireWallRules compare results, and is compliant with
 // Golang slices methods `SortFunc` and `BinarySearchFunc`:
 // https://pkg.go.dev/golang.org/x/exp/slices
@@ -70,8 +133,98 @@ func CompareFirewallRules(a, b FirewallRule) int {
 	// Rules have same type.
 	switch a.Type {
 	case FirewallRuleTypeManagedRange:
-		return strings.Compare(a.RangeID, b.RangeID)
+		return cmp.Or(
+			strings.Compare(a.RangeID, b.RangeID),
+			strings.Compare(a.RangeName, b.RangeName),
+		)
 	default:
 		return strings.Compare(a.CIDR, b.CIDR)
 	}
 }
+
+// HasClusterEgressFirewallRule returns whether any rule is resolved from the cluster egress IPs.
+func HasClusterEgressFirewallRule(rules []FirewallRule) bool {
+	return slices.ContainsFunc(rules, func(rule FirewallRule) bool {
+		return rule.Type == FirewallRuleTypeClusterEgress
+	})
+}
+
+// ExpandClusterEgressFirewallRules replaces every cluster egress rule with a custom range rule per
+// cluster egress IP, keeping the rule label.
+func ExpandClusterEgressFirewallRules(rules []FirewallRule, clusterEgressIPs []net.IP) []FirewallRule {
+	expandedRules := make([]FirewallRule, 0, len(rules)+len(clusterEgressIPs))
+	for _, rule := range rules {
+		if rule.Type != FirewallRuleTypeClusterEgress {
+			expandedRules = append(expandedRules, rule)
+			continue
+		}
+
+		for _, ip := range clusterEgressIPs {
+			cidr := ip.String() + "/32"
+			if ip.To4() == nil {
+				cidr = ip.String() + "/128"
+			}
+			expandedRules = append(expandedRules, FirewallRule{
+				Type:  FirewallRuleTypeCustomRange,
+				CIDR:  cidr,
+				Label: rule.Label,
+			})
+		}
+	}
+
+	// Remove the rules duplicated by several cluster egress rules, or by an explicit custom range.
+	return DeduplicateFirewallRules(expandedRules)
+}
+
+// DeduplicateFirewallRules sorts the rules and removes the duplicated ones, keeping the first occurrence.
+func DeduplicateFirewallRules(rules []FirewallRule) []FirewallRule {
+	slices.SortStableFunc(rules, CompareFirewallRules)
+	return slices.CompactFunc(rules, func(a, b FirewallRule) bool {
+		return CompareFirewallRules(a, b) == 0
+	})
+}
+
+// HasFirewallManagedRangeName returns whether any managed range rule references its range by name.
+func HasFirewallManagedRangeName(rules []FirewallRule) bool {
+	return slices.ContainsFunc(rules, func(rule FirewallRule) bool {
+		return rule.Type == FirewallRuleTypeManagedRange && rule.RangeName != ""
+	})
+}
+
+// ResolveFirewallManagedRangeNames replaces the range name of the managed range rules with the ID of the
+// matching range. It returns the resolved rules and ranges, or ErrFirewallManagedRangeNotFound listing the
+// unknown names.
+func ResolveFirewallManagedRangeNames(rules []FirewallRule, ranges []FirewallManagedRange) ([]FirewallRule, []FirewallManagedRange, error) {
+	resolvedRules := make([]FirewallRule, 0, len(rules))
+	var (
+		resolvedRanges []FirewallManagedRange
+		unknownNames   []string
+	)
+	for _, rule := range rules {
+		if rule.Type != FirewallRuleTypeManagedRange || rule.RangeName == "" {
+			resolvedRules = append(resolvedRules, rule)
+			continue
+		}
+
+		i := slices.IndexFunc(ranges, func(managedRange FirewallManagedRange) bool {
+			return managedRange.Name == rule.RangeName
+		})
+		if i < 0 {
+			unknownNames = append(unknownNames, strconv.Quote(rule.RangeName))
+			continue
+		}
+
+		rule.RangeID = ranges[i].ID
+		rule.RangeName = ""
+		resolvedRules = append(resolvedRules, rule)
+		if !slices.Contains(resolvedRanges, ranges[i]) {
+			resolvedRanges = append(resolvedRanges, ranges[i])
+		}
+	}
+	if len(unknownNames) > 0 {
+		return nil, nil, fmt.Errorf("%w: %s", ErrFirewallManagedRangeNotFound, strings.Join(slices.Compact(slices.Sorted(slices.Values(unknownNames))), ", "))
+	}
+
+	// Remove the rules referencing the same range by name and by ID.
+	return DeduplicateFirewallRules(resolvedRules), resolvedRanges, nil
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -76,6 +77,39 @@ func TestFirewallRule_Validate(t *testing.T) {
 		require.ErrorContains(t, rule.Validate(), "missing range_id")
 	})
 
+	t.Run("it successfully validates managed_range rule with range_name", func(t *testing.T) {
+		rule := FirewallRule{
+			Type:      FirewallRuleTypeManagedRange,
+			RangeName: "Scalingo osc-fr1 apps",
+		}
+		require.NoError(t, rule.Validate())
+	})
+
+	t.Run("it returns error for managed_range with both range_id and range_name", func(t *testing.T) {
+		rule := FirewallRule{
+			Type:      FirewallRuleTypeManagedRange,
+			RangeID:   "range-123",
+			RangeName: "Scalingo osc-fr1 apps",
+		}
+		require.ErrorContains(t, rule.Validate(), "mutually exclusive")
+	})
+
+	t.Run("it successfully validates custom_range rule with IPv6 cidr", func(t *testing.T) {
+		rule := FirewallRule{
+			Type: FirewallRuleTypeCustomRange,
+			CIDR: "2001:db8::/32",
+		}
+		require.NoError(t, rule.Validate())
+	})
+
+	t.Run("it returns error for custom_range with invalid cidr", func(t *testing.T) {
+		rule := FirewallRule{
+			Type: FirewallRuleTypeCustomRange,
+			CIDR: "10.0.0.0/33",
+		}
+		require.ErrorContains(t, rule.Validate(), "invalid cidr")
+	})
+
 	t.Run("it returns error for custom_range without cidr", func(t *testing.T) {
 		rule := FirewallRule{
 			Type: FirewallRuleTypeCustomRange,
@@ -85,6 +119,36 @@ func TestFirewallRule_Validate(t *testing.T) {
 	})
 }
 
+func TestNormalizeCIDR(t *testing.T) {
+	for cidr, expected := range map[string]string{
+		"10.0.0.0/8":          "10.0.0.0/8",
+		"10.0.0.1/8":          "10.0.0.0/8",
+		"192.168.1.1/32":      "192.168.1.1/32",
+		"2001:db8::/32":       "2001:db8::/32",
+		"2001:DB8:0:0:1::/64": "2001:db8::/64",
+		"2001:db8::1/128":     "2001:db8::1/128",
+	} {
+		res, err := NormalizeCIDR(cidr)
+
+		require.NoError(t, err, cidr)
+		require.Equal(t, expected, res, cidr)
+	}
+
+	for _, cidr := range []string{"10.0.0.0", "10.0.0.0/33", "300.0.0.0/8", "2001:db8::/129", "not-a-cidr"} {
+		_, err := NormalizeCIDR(cidr)
+
+		require.ErrorContains(t, err, "invalid cidr", cidr)
+	}
+}
+
+func TestFirewallRule_Normalize(t *testing.T) {
+	rule, err := FirewallRule{Type: FirewallRuleTypeCustomRange, CIDR: "10.0.0.1/8", Label: "Office"}.Normalize()
+
+	require.NoError(t, err)
+	require.Equal(t, FirewallRule{Type: FirewallRuleTypeCustomRange, CIDR: "10.0.0.0/8", Label: "Office"}, rule)
+	require.Zero(t, CompareFirewallRules(rule, FirewallRule{Type: FirewallRuleTypeCustomRange, CIDR: "10.0.0.0/8"}))
+}
+
 func TestFirewallRulesCompare(t *testing.T) {
 	t.Run("it compares rules by types only", func(t *testing.T) {
 		ruleManagedRange := FirewallRule{Type: FirewallRuleTypeManagedRange}
@@ -124,3 +188,94 @@ func TestFirewallRulesCompare(t *testing.T) {
 		require.Negative(t, CompareFirewallRules(rule2, rule3))
 	})
 }
+
+func TestHasClusterEgressFirewallRule(t *testing.T) {
+	require.False(t, HasClusterEgressFirewallRule(nil))
+	require.False(t, HasClusterEgressFirewallRule([]FirewallRule{{Type: FirewallRuleTypeCustomRange, CIDR: "10.0.0.0/8"}}))
+	require.True(t, HasClusterEgressFirewallRule([]FirewallRule{{Type: FirewallRuleTypeClusterEgress}}))
+}
+
+func TestExpandClusterEgressFirewallRules(t *testing.T) {
+	t.Run("it replaces the cluster egress rule with custom ranges", func(t *testing.T) {
+		rules := []FirewallRule{
+			{Type: FirewallRuleTypeManagedRange, RangeID: "range-123"},
+			{Type: FirewallRuleTypeClusterEgress, Label: "Cluster egress"},
+		}
+		ips := []net.IP{net.ParseIP("203.0.113.2"), net.ParseIP("203.0.113.1"), net.ParseIP("2001:db8::1")}
+
+		res := ExpandClusterEgressFirewallRules(rules, ips)
+
+		require.Equal(t, []FirewallRule{
+			{Type: FirewallRuleTypeCustomRange, CIDR: "2001:db8::1/128", Label: "Cluster egress"},
+			{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.1/32", Label: "Cluster egress"},
+			{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.2/32", Label: "Cluster egress"},
+			{Type: FirewallRuleTypeManagedRange, RangeID: "range-123"},
+		}, res)
+	})
+
+	t.Run("it removes duplicated custom ranges", func(t *testing.T) {
+		rules := []FirewallRule{
+			{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.1/32", Label: "Explicit"},
+			{Type: FirewallRuleTypeClusterEgress},
+		}
+
+		res := ExpandClusterEgressFirewallRules(rules, []net.IP{net.ParseIP("203.0.113.1")})
+
+		require.Equal(t, []FirewallRule{
+			{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.1/32", Label: "Explicit"},
+		}, res)
+	})
+}
+
+func TestDeduplicateFirewallRules(t *testing.T) {
+	rules := []FirewallRule{
+		{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.1/32", Label: "Inline"},
+		{Type: FirewallRuleTypeManagedRange, RangeID: "range-123"},
+		{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.1/32", Label: "From set"},
+	}
+
+	res := DeduplicateFirewallRules(rules)
+
+	require.Equal(t, []FirewallRule{
+		{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.1/32", Label: "Inline"},
+		{Type: FirewallRuleTypeManagedRange, RangeID: "range-123"},
+	}, res)
+}
+
+func TestResolveFirewallManagedRangeNames(t *testing.T) {
+	ranges := []FirewallManagedRange{
+		{ID: "man-osc-fr1-egress", Name: "Scalingo osc-fr1 apps"},
+		{ID: "man-osc-secnum-fr1-egress", Name: "Scalingo osc-secnum-fr1 apps"},
+	}
+
+	t.Run("it replaces the range names with the range IDs", func(t *testing.T) {
+		rules := []FirewallRule{
+			{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.1/32"},
+			{Type: FirewallRuleTypeManagedRange, RangeName: "Scalingo osc-fr1 apps", Label: "Apps"},
+			{Type: FirewallRuleTypeManagedRange, RangeID: "man-osc-fr1-egress"},
+		}
+		require.True(t, HasFirewallManagedRangeName(rules))
+
+		resolvedRules, resolvedRanges, err := ResolveFirewallManagedRangeNames(rules, ranges)
+
+		require.NoError(t, err)
+		require.False(t, HasFirewallManagedRangeName(resolvedRules))
+		require.Equal(t, []FirewallRule{
+			{Type: FirewallRuleTypeCustomRange, CIDR: "203.0.113.1/32"},
+			{Type: FirewallRuleTypeManagedRange, RangeID: "man-osc-fr1-egress", Label: "Apps"},
+		}, resolvedRules)
+		require.Equal(t, []FirewallManagedRange{ranges[0]}, resolvedRanges)
+	})
+
+	t.Run("it fails when a range name does not exist", func(t *testing.T) {
+		rules := []FirewallRule{
+			{Type: FirewallRuleTypeManagedRange, RangeName: "Scalingo osc-fr1 apps"},
+			{Type: FirewallRuleTypeManagedRange, RangeName: "Unknown range"},
+		}
+
+		_, _, err := ResolveFirewallManagedRangeNames(rules, ranges)
+
+		require.ErrorIs(t, err, ErrFirewallManagedRangeNotFound)
+		require.EqualError(t, err, `firewall managed range not found: "Unknown range"`)
+	})
+}
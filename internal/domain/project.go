@@ -0,0 +1,17 @@
+package domain
+
+type Project struct {
+	ID      string
+	Name    string
+	Default bool
+
+	// PrivateNetwork is the private network of the project, nil until the project has one.
+	PrivateNetwork *ProjectPrivateNetwork
+}
+
+type ProjectPrivateNetwork struct {
+	ID          string
+	Subnet      string
+	Gateway     string
+	MaxIPsCount int
+}
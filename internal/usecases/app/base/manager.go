@@ -0,0 +1,192 @@
+package app
+
+import (
+	"context"
+
+	errors "github.com/Scalingo/go-utils/errors/v3"
+	scalingo "github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo"
+	scalingobase "github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/base"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	"github.com/Scalingo/scalingo-operator/internal/usecases/app"
+)
+
+type manager struct {
+	scClient scalingo.Client
+}
+
+// NewManager returns the manager of the Scalingo applications.
+func NewManager(ctx context.Context, apiToken, region string) (app.Manager, error) {
+	if apiToken == "" {
+		return nil, errors.New(ctx, "empty api token")
+	}
+
+	scClient, err := scalingobase.NewClient(ctx, apiToken, region)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "new scalingo client")
+	}
+
+	return &manager{
+		scClient: scClient,
+	}, nil
+}
+
+// CreateApp creates the application in its project. Its stack, variables and containers are applied by
+// UpdateApp.
+func (m *manager) CreateApp(ctx context.Context, app domain.App) (domain.App, error) {
+	return m.scClient.CreateApp(ctx, app)
+}
+
+// GetApp returns the application with its variables and container types.
+func (m *manager) GetApp(ctx context.Context, appID string) (domain.App, error) {
+	app, err := m.scClient.GetApp(ctx, appID)
+	if err != nil {
+		return domain.App{}, errors.Wrap(ctx, err, "get app")
+	}
+
+	app.Variables, err = m.scClient.ListAppVariables(ctx, appID)
+	if err != nil {
+		return domain.App{}, errors.Wrap(ctx, err, "list app variables")
+	}
+
+	app.Containers, err = m.scClient.ListAppContainers(ctx, appID)
+	if err != nil {
+		return domain.App{}, errors.Wrap(ctx, err, "list app containers")
+	}
+	return app, nil
+}
+
+// UpdateApp applies the stack, the project, the variables and the container types of the expected application
+// when they differ from the current ones, and unsets the removed variables. It returns the updated application.
+// The expected container types missing from the current formation, e.g. not yet deployed, are not scaled.
+func (m *manager) UpdateApp(ctx context.Context, appID string, expectedApp domain.App, removedVariableNames []string) (domain.App, error) {
+	app, err := m.GetApp(ctx, appID)
+	if err != nil {
+		return domain.App{}, err
+	}
+	isUpdated := false
+
+	if expectedApp.Stack != "" {
+		stackID, err := m.findStackID(ctx, expectedApp.Stack)
+		if err != nil {
+			return domain.App{}, err
+		}
+
+		if stackID != app.Stack {
+			err = m.scClient.SetAppStack(ctx, appID, stackID)
+			if err != nil {
+				return domain.App{}, errors.Wrap(ctx, err, "set app stack")
+			}
+			isUpdated = true
+		}
+	}
+
+	if expectedApp.ProjectID != "" && expectedApp.ProjectID != app.ProjectID {
+		err = m.scClient.SetAppProject(ctx, appID, expectedApp.ProjectID)
+		if err != nil {
+			return domain.App{}, errors.Wrap(ctx, err, "set app project")
+		}
+		isUpdated = true
+	}
+
+	changedVariables, unsetVariableIDs := diffVariables(app.Variables, expectedApp.Variables, removedVariableNames)
+	if len(changedVariables) > 0 {
+		err = m.scClient.SetAppVariables(ctx, appID, changedVariables)
+		if err != nil {
+			return domain.App{}, errors.Wrap(ctx, err, "set app variables")
+		}
+		isUpdated = true
+	}
+	for _, variableID := range unsetVariableIDs {
+		err = m.scClient.UnsetAppVariable(ctx, appID, variableID)
+		if err != nil {
+			return domain.App{}, errors.Wrap(ctx, err, "unset app variable")
+		}
+		isUpdated = true
+	}
+
+	changedContainers := diffContainers(app.Containers, expectedApp.Containers)
+	if len(changedContainers) > 0 {
+		err = m.scClient.ScaleApp(ctx, appID, changedContainers)
+		if err != nil {
+			return domain.App{}, errors.Wrap(ctx, err, "scale app")
+		}
+		isUpdated = true
+	}
+
+	if !isUpdated {
+		return app, nil
+	}
+	return m.GetApp(ctx, appID)
+}
+
+// DeleteApp deletes the application. An application already deleted is not an error.
+func (m *manager) DeleteApp(ctx context.Context, app domain.App) error {
+	err := m.scClient.DeleteApp(ctx, app)
+	if err != nil && !errors.Is(err, domain.ErrApplicationNotFound) {
+		return errors.Wrap(ctx, err, "delete app")
+	}
+	return nil
+}
+
+// findStackID returns the ID of the stack with the given name or ID.
+func (m *manager) findStackID(ctx context.Context, stackNameOrID string) (string, error) {
+	stacks, err := m.scClient.ListStacks(ctx)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "list stacks")
+	}
+
+	for _, stack := range stacks {
+		if stack.ID == stackNameOrID || stack.Name == stackNameOrID {
+			return stack.ID, nil
+		}
+	}
+	return "", errors.Newf(ctx, "stack %s not found", stackNameOrID)
+}
+
+// diffVariables returns the expected variables missing or with another value, and the IDs of the current
+// variables removed from the expected ones.
+func diffVariables(currentVariables, expectedVariables []domain.AppVariable, removedVariableNames []string) ([]domain.AppVariable, []string) {
+	currentByName := make(map[string]domain.AppVariable, len(currentVariables))
+	for _, variable := range currentVariables {
+		currentByName[variable.Name] = variable
+	}
+
+	var changedVariables []domain.AppVariable
+	for _, variable := range expectedVariables {
+		current, ok := currentByName[variable.Name]
+		if !ok || current.Value != variable.Value {
+			changedVariables = append(changedVariables, variable)
+		}
+	}
+
+	var unsetVariableIDs []string
+	for _, name := range removedVariableNames {
+		current, ok := currentByName[name]
+		if ok {
+			unsetVariableIDs = append(unsetVariableIDs, current.ID)
+		}
+	}
+	return changedVariables, unsetVariableIDs
+}
+
+// diffContainers returns the expected container types whose amount or size differ from the current ones. The
+// container types missing from the current ones are skipped, as Scalingo can only scale the container types of
+// the deployed Procfile.
+func diffContainers(currentContainers, expectedContainers []domain.AppContainer) []domain.AppContainer {
+	currentByName := make(map[string]domain.AppContainer, len(currentContainers))
+	for _, container := range currentContainers {
+		currentByName[container.Name] = container
+	}
+
+	var changedContainers []domain.AppContainer
+	for _, container := range expectedContainers {
+		current, ok := currentByName[container.Name]
+		if !ok {
+			continue
+		}
+		if current.Amount != container.Amount || (container.Size != "" && current.Size != container.Size) {
+			changedContainers = append(changedContainers, container)
+		}
+	}
+	return changedContainers
+}
@@ -0,0 +1,207 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/scalingomock"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+const appID = "app_test_id"
+
+func TestNewManager(t *testing.T) {
+	t.Run("it fails because of empty API token", func(t *testing.T) {
+		ctx := t.Context()
+		appManager, err := NewManager(ctx, "", "")
+
+		require.EqualError(t, err, "empty api token")
+		require.Nil(t, appManager)
+	})
+}
+
+func TestManager_GetApp(t *testing.T) {
+	t.Run("it returns the app with its variables and containers", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		variables := []domain.AppVariable{{ID: "var-1", Name: "LOG_LEVEL", Value: "debug"}}
+		containers := []domain.AppContainer{{Name: "web", Amount: 1, Size: "M"}}
+		scClient.EXPECT().GetApp(ctx, appID).Return(domain.App{ID: appID, Name: "my-app"}, nil)
+		scClient.EXPECT().ListAppVariables(ctx, appID).Return(variables, nil)
+		scClient.EXPECT().ListAppContainers(ctx, appID).Return(containers, nil)
+
+		app, err := manager.GetApp(ctx, appID)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.App{ID: appID, Name: "my-app", Variables: variables, Containers: containers}, app)
+	})
+
+	t.Run("it fails when the app is not found", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetApp(ctx, appID).Return(domain.App{}, domain.ErrApplicationNotFound)
+
+		_, err := manager.GetApp(ctx, appID)
+
+		require.ErrorIs(t, err, domain.ErrApplicationNotFound)
+	})
+}
+
+func TestManager_UpdateApp(t *testing.T) {
+	currentApp := domain.App{ID: appID, Name: "my-app", ProjectID: "prj-1", Stack: "st-22"}
+	currentVariables := []domain.AppVariable{
+		{ID: "var-1", Name: "LOG_LEVEL", Value: "debug"},
+		{ID: "var-2", Name: "OLD_VAR", Value: "old"},
+	}
+	currentContainers := []domain.AppContainer{{Name: "web", Amount: 1, Size: "M"}}
+
+	expectGetApp := func(scClient *scalingomock.MockClient) {
+		scClient.EXPECT().GetApp(gomock.Any(), appID).Return(currentApp, nil)
+		scClient.EXPECT().ListAppVariables(gomock.Any(), appID).Return(currentVariables, nil)
+		scClient.EXPECT().ListAppContainers(gomock.Any(), appID).Return(currentContainers, nil)
+	}
+
+	t.Run("it does nothing when the app is up to date", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		expectGetApp(scClient)
+		scClient.EXPECT().ListStacks(ctx).Return([]domain.Stack{{ID: "st-22", Name: "scalingo-22"}}, nil)
+
+		app, err := manager.UpdateApp(ctx, appID, domain.App{
+			ProjectID:  "prj-1",
+			Stack:      "scalingo-22",
+			Variables:  []domain.AppVariable{{Name: "LOG_LEVEL", Value: "debug"}},
+			Containers: []domain.AppContainer{{Name: "web", Amount: 1}},
+		}, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, appID, app.ID)
+	})
+
+	t.Run("it applies the differences", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		expectGetApp(scClient)
+		scClient.EXPECT().ListStacks(ctx).Return([]domain.Stack{{ID: "st-22", Name: "scalingo-22"}, {ID: "st-24", Name: "scalingo-24"}}, nil)
+		scClient.EXPECT().SetAppStack(ctx, appID, "st-24").Return(nil)
+		scClient.EXPECT().SetAppProject(ctx, appID, "prj-2").Return(nil)
+		scClient.EXPECT().SetAppVariables(ctx, appID, []domain.AppVariable{
+			{Name: "LOG_LEVEL", Value: "info"},
+			{Name: "NEW_VAR", Value: "new"},
+		}).Return(nil)
+		scClient.EXPECT().UnsetAppVariable(ctx, appID, "var-2").Return(nil)
+		scClient.EXPECT().ScaleApp(ctx, appID, []domain.AppContainer{
+			{Name: "web", Amount: 2, Size: "S"},
+		}).Return(nil)
+		expectGetApp(scClient)
+
+		_, err := manager.UpdateApp(ctx, appID, domain.App{
+			ProjectID: "prj-2",
+			Stack:     "scalingo-24",
+			Variables: []domain.AppVariable{
+				{Name: "LOG_LEVEL", Value: "info"},
+				{Name: "NEW_VAR", Value: "new"},
+			},
+			Containers: []domain.AppContainer{
+				{Name: "web", Amount: 2, Size: "S"},
+			},
+		}, []string{"OLD_VAR", "ALREADY_UNSET"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it does not scale the container types missing from the formation", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		expectGetApp(scClient)
+		scClient.EXPECT().ScaleApp(ctx, appID, []domain.AppContainer{{Name: "web", Amount: 2}}).Return(nil)
+		expectGetApp(scClient)
+
+		app, err := manager.UpdateApp(ctx, appID, domain.App{
+			Containers: []domain.AppContainer{
+				{Name: "web", Amount: 2},
+				{Name: "worker", Amount: 1},
+			},
+		}, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, currentContainers, app.Containers)
+	})
+
+	t.Run("it does not scale the app when only missing container types are expected", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		expectGetApp(scClient)
+
+		_, err := manager.UpdateApp(ctx, appID, domain.App{
+			Containers: []domain.AppContainer{{Name: "worker", Amount: 1}},
+		}, nil)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it fails when the stack does not exist", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		expectGetApp(scClient)
+		scClient.EXPECT().ListStacks(ctx).Return([]domain.Stack{{ID: "st-22", Name: "scalingo-22"}}, nil)
+
+		_, err := manager.UpdateApp(ctx, appID, domain.App{Stack: "scalingo-18"}, nil)
+
+		require.EqualError(t, err, "stack scalingo-18 not found")
+	})
+}
+
+func TestManager_DeleteApp(t *testing.T) {
+	t.Run("it ignores an app already deleted", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		app := domain.App{ID: appID, Name: "my-app"}
+		scClient.EXPECT().DeleteApp(ctx, app).Return(domain.ErrApplicationNotFound)
+
+		err := manager.DeleteApp(ctx, app)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it fails when the deletion fails", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		app := domain.App{ID: appID, Name: "my-app"}
+		scClient.EXPECT().DeleteApp(ctx, app).Return(errors.New("boom"))
+
+		err := manager.DeleteApp(ctx, app)
+
+		require.ErrorContains(t, err, "boom")
+	})
+}
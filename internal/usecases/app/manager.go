@@ -0,0 +1,14 @@
+package app
+
+import (
+	"context"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+type Manager interface {
+	CreateApp(ctx context.Context, app domain.App) (domain.App, error)
+	GetApp(ctx context.Context, appID string) (domain.App, error)
+	UpdateApp(ctx context.Context, appID string, expectedApp domain.App, removedVariableNames []string) (domain.App, error)
+	DeleteApp(ctx context.Context, app domain.App) error
+}
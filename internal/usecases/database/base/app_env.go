@@ -0,0 +1,60 @@
+package database
+
+import (
+	"context"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// EnsureAppVariable sets the environment variable of the application, unless it already has the value, and
+// returns whether the variable changed.
+func (m *manager) EnsureAppVariable(ctx context.Context, appID, name, value string) (bool, error) {
+	if appID == "" {
+		return false, errors.New(ctx, "empty app id")
+	}
+	if name == "" {
+		return false, errors.New(ctx, "empty variable name")
+	}
+
+	variables, err := m.scClient.ListAppVariables(ctx, appID)
+	if err != nil {
+		return false, errors.Wrapf(ctx, err, "list variables of app %s", appID)
+	}
+	for _, variable := range variables {
+		if variable.Name == name && variable.Value == value {
+			return false, nil
+		}
+	}
+
+	err = m.scClient.SetAppVariables(ctx, appID, []domain.AppVariable{{Name: name, Value: value}})
+	if err != nil {
+		return false, errors.Wrapf(ctx, err, "set variable %s of app %s", name, appID)
+	}
+	return true, nil
+}
+
+// UnsetAppVariable unsets the environment variable of the application. A variable or an application already
+// deleted is not an error.
+func (m *manager) UnsetAppVariable(ctx context.Context, appID, name string) error {
+	if appID == "" {
+		return errors.New(ctx, "empty app id")
+	}
+
+	variables, err := m.scClient.ListAppVariables(ctx, appID)
+	if errors.Is(err, domain.ErrApplicationNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(ctx, err, "list variables of app %s", appID)
+	}
+	for _, variable := range variables {
+		if variable.Name != name {
+			continue
+		}
+		err = m.scClient.UnsetAppVariable(ctx, appID, variable.ID)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "unset variable %s of app %s", name, appID)
+		}
+	}
+	return nil
+}
@@ -53,12 +53,41 @@ func (m *manager) GetDatabaseURL(ctx context.Context, db domain.Database) (domai
 	if err != nil {
 		return domain.DatabaseURL{}, errors.Wrap(ctx, err, "find database url")
 	}
+
+	// MongoDB clients connect to the replica set members rather than to a single host.
+	if db.Type == domain.DatabaseTypeMongoDB && db.MongoReplSetName != "" {
+		varValue, err = domain.ComposeReplicaSetConnectionURL(ctx, varValue, db.MongoReplSetName, db.Instances)
+		if err != nil {
+			return domain.DatabaseURL{}, errors.Wrap(ctx, err, "compose replica set connection url")
+		}
+	}
 	return domain.DatabaseURL{
 		Name:  varName,
 		Value: varValue,
 	}, nil
 }
 
+// GetDatabaseDashboardsURL returns the URL of the Kibana or OpenSearch Dashboards of the database, empty when
+// the database does not provide any.
+func (m *manager) GetDatabaseDashboardsURL(ctx context.Context, db domain.Database) (string, error) {
+	dashboardsURL, err := m.scClient.GetDatabaseDashboardsURL(ctx, db.AppID, db.AddonID)
+	if err != nil {
+		return "", errors.Wrapf(ctx, err, "get database %s dashboards url", db.ID)
+	}
+	return dashboardsURL, nil
+}
+
+func (m *manager) GetDatabaseCACertificate(ctx context.Context, db domain.Database) (string, error) {
+	caCertificate, err := m.scClient.GetDatabaseCACertificate(ctx, db.AppID, db.AddonID)
+	if err != nil {
+		return "", errors.Wrapf(ctx, err, "get database %s ca certificate", db.ID)
+	}
+	if caCertificate == "" {
+		return "", errors.Newf(ctx, "empty ca certificate for database %s", db.ID)
+	}
+	return caCertificate, nil
+}
+
 func (m *manager) UpdateDatabase(ctx context.Context, dbID string, expectedDB domain.Database) (domain.DatabaseStatus, error) {
 	db, err := m.GetDatabase(ctx, dbID)
 	if err != nil {
@@ -0,0 +1,44 @@
+package database
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	errors "github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+// updateFeatures enables or disables the expected features which differ from the current ones. The features
+// are only updated on a running database.
+func (m *manager) updateFeatures(ctx context.Context, currentDB domain.Database, expectedFeatures map[string]bool) error {
+	log := logf.FromContext(ctx)
+
+	if len(expectedFeatures) == 0 || currentDB.Status != domain.DatabaseStatusRunning {
+		return nil
+	}
+
+	for _, feature := range slices.Sorted(maps.Keys(expectedFeatures)) {
+		isEnabled := expectedFeatures[feature]
+		if currentDB.Features[feature] == isEnabled {
+			continue
+		}
+
+		if isEnabled {
+			log.Info("Enable database feature", "feature", feature)
+			err := m.scClient.EnableDatabaseFeature(ctx, currentDB.AppID, currentDB.AddonID, feature)
+			if err != nil {
+				return errors.Wrapf(ctx, err, "enable feature %s", feature)
+			}
+		} else {
+			log.Info("Disable database feature", "feature", feature)
+			err := m.scClient.DisableDatabaseFeature(ctx, currentDB.AppID, currentDB.AddonID, feature)
+			if err != nil {
+				return errors.Wrapf(ctx, err, "disable feature %s", feature)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/scalingomock"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestManager_updateFeatures(t *testing.T) {
+	runningDB := domain.Database{
+		ID:      databaseID,
+		AppID:   appID,
+		AddonID: addonID,
+		Status:  domain.DatabaseStatusRunning,
+		Features: map[string]bool{
+			"redis-aof": true,
+		},
+	}
+
+	t.Run("it does nothing while the database is provisioning", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		manager := manager{scClient: scalingomock.NewMockClient(ctrl)}
+		currentDB := runningDB
+		currentDB.Status = domain.DatabaseStatusProvisioning
+
+		err := manager.updateFeatures(ctx, currentDB, map[string]bool{"redis-aof": false})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it only updates the features which differ", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().DisableDatabaseFeature(ctx, appID, addonID, "redis-aof").Return(nil)
+		scClient.EXPECT().EnableDatabaseFeature(ctx, appID, addonID, "redis-eviction-policy-allkeys-lru").Return(nil)
+
+		err := manager.updateFeatures(ctx, runningDB, map[string]bool{
+			"redis-aof":                         false,
+			"redis-eviction-policy-allkeys-lru": true,
+			"redis-eviction-policy-noeviction":  false,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it fails when enabling a feature fails", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().EnableDatabaseFeature(ctx, appID, addonID, "force-ssl").Return(errors.New("boom"))
+
+		err := manager.updateFeatures(ctx, runningDB, map[string]bool{"force-ssl": true})
+
+		require.EqualError(t, err, "enable feature force-ssl: boom")
+	})
+}
@@ -11,26 +11,61 @@ import (
 	"github.com/Scalingo/scalingo-operator/internal/domain"
 )
 
-// updateFirewallRules adds or delete rules so as to bring the current firewall rules to the expected ones.
-func (m *manager) updateFirewallRules(ctx context.Context, currentDB domain.Database, expectedRules []domain.FirewallRule) error {
-	rulesToApply := establishRulesToApply(currentDB.FireWallRules, expectedRules)
-
-	g, ctx := errgroup.WithContext(ctx)
-	for _, ruleToApply := range rulesToApply {
-		g.Go(func() error {
-			switch ruleToApply.action {
-			case addRuleAction:
-				return m.addFirewallRule(ctx, currentDB.ID, currentDB.AddonID, ruleToApply.rule)
-			case deleteRuleAction:
-				return m.deleteFirewallRule(ctx, currentDB.ID, currentDB.AddonID, ruleToApply.rule)
-			default:
-				return errors.Newf(ctx, "undefined rule action %v", ruleToApply.action)
-			}
-		})
+// ResolveFirewallManagedRanges replaces the managed range names of the rules with the ranges ID available
+// to the database. It returns the resolved rules and ranges.
+func (m *manager) ResolveFirewallManagedRanges(ctx context.Context, dbID string, rules []domain.FirewallRule) ([]domain.FirewallRule, []domain.FirewallManagedRange, error) {
+	if dbID == "" {
+		return nil, nil, errors.New(ctx, "empty database id")
+	}
+	if !domain.HasFirewallManagedRangeName(rules) {
+		return rules, nil, nil
+	}
+
+	db, err := m.scClient.GetDatabase(ctx, dbID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(ctx, err, "get database %s", dbID)
 	}
-	err := g.Wait()
+
+	ranges, err := m.scClient.ListFirewallManagedRanges(ctx, db.ID, db.AddonID)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, "list firewall managed ranges")
+	}
+
+	resolvedRules, resolvedRanges, err := domain.ResolveFirewallManagedRangeNames(rules, ranges)
 	if err != nil {
-		return errors.Wrap(ctx, err, "update firewall rules")
+		return nil, nil, errors.Wrap(ctx, err, "resolve firewall managed range names")
+	}
+	return resolvedRules, resolvedRanges, nil
+}
+
+// updateFirewallRules adds or delete rules so as to bring the current firewall rules to the expected ones.
+// The rules not created by the operator are only deleted when the firewall is exclusive.
+func (m *manager) updateFirewallRules(ctx context.Context, currentDB domain.Database, expectedRules []domain.FirewallRule, isExclusive bool) error {
+	rulesToApply := establishRulesToApply(currentDB.FireWallRules, expectedRules, isExclusive)
+
+	// Add the rules before deleting the ones they replace, e.g. the rules adopted from a previous operator
+	// version, so that the range stays allowed and nothing is deleted when an add fails.
+	for _, action := range []ruleToApplyAction{addRuleAction, deleteRuleAction} {
+		g, gctx := errgroup.WithContext(ctx)
+		for _, ruleToApply := range rulesToApply {
+			if ruleToApply.action != action {
+				continue
+			}
+			g.Go(func() error {
+				switch ruleToApply.action {
+				case addRuleAction:
+					return m.addFirewallRule(gctx, currentDB.ID, currentDB.AddonID, ruleToApply.rule)
+				case deleteRuleAction:
+					return m.deleteFirewallRule(gctx, currentDB.ID, currentDB.AddonID, ruleToApply.rule)
+				default:
+					return errors.Newf(gctx, "undefined rule action %v", ruleToApply.action)
+				}
+			})
+		}
+		err := g.Wait()
+		if err != nil {
+			return errors.Wrap(ctx, err, "update firewall rules")
+		}
 	}
 	return nil
 }
@@ -74,7 +109,11 @@ type ruleToApply struct {
 type rulesToApply []ruleToApply
 
 // establishRulesToApply compares two FirewallRule slices so as to establish the rules to apply.
-func establishRulesToApply(currentRules []domain.FirewallRule, expectedRules []domain.FirewallRule) rulesToApply {
+// A rule whose label changed is replaced, i.e. added then deleted. A rule not created by the operator but
+// matching an expected rule, e.g. created by an operator version without ownership marker, is adopted: it is
+// replaced by the marked expected rule. The other current rules not created by the operator are left alone,
+// unless the firewall is exclusive.
+func establishRulesToApply(currentRules []domain.FirewallRule, expectedRules []domain.FirewallRule, isExclusive bool) rulesToApply {
 	// Sort slices to further use BinarySearchFunc.
 	slices.SortFunc(currentRules, domain.CompareFirewallRules)
 	slices.SortFunc(expectedRules, domain.CompareFirewallRules)
@@ -82,12 +121,21 @@ func establishRulesToApply(currentRules []domain.FirewallRule, expectedRules []d
 	res := make(rulesToApply, 0, len(expectedRules)+len(currentRules))
 
 	for _, rule := range expectedRules {
-		_, found := slices.BinarySearchFunc(currentRules, rule, domain.CompareFirewallRules)
+		i, found := slices.BinarySearchFunc(currentRules, rule, domain.CompareFirewallRules)
 		if !found {
 			res = append(res, ruleToApply{rule, addRuleAction})
+			continue
+		}
+
+		currentRule := currentRules[i]
+		if currentRule.Label != rule.Label || !currentRule.IsManagedByOperator() {
+			res = append(res, ruleToApply{currentRule, deleteRuleAction}, ruleToApply{rule, addRuleAction})
 		}
 	}
 	for _, rule := range currentRules {
+		if !rule.IsManagedByOperator() && !isExclusive {
+			continue
+		}
 		_, found := slices.BinarySearchFunc(expectedRules, rule, domain.CompareFirewallRules)
 		if !found {
 			res = append(res, ruleToApply{rule, deleteRuleAction})
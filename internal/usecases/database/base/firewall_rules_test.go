@@ -11,6 +11,70 @@ import (
 	"github.com/Scalingo/scalingo-operator/internal/domain"
 )
 
+func TestManager_ResolveFirewallManagedRanges(t *testing.T) {
+	managedRangeRule := domain.FirewallRule{Type: domain.FirewallRuleTypeManagedRange, RangeName: "Scalingo osc-fr1 apps"}
+
+	t.Run("it does not call the API without range name", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		manager := manager{scClient: scalingomock.NewMockClient(ctrl)}
+		rules := []domain.FirewallRule{{Type: domain.FirewallRuleTypeManagedRange, RangeID: "man-osc-fr1-egress"}}
+
+		resolvedRules, resolvedRanges, err := manager.ResolveFirewallManagedRanges(ctx, databaseID, rules)
+
+		require.NoError(t, err)
+		require.Equal(t, rules, resolvedRules)
+		require.Empty(t, resolvedRanges)
+	})
+
+	t.Run("it fails when listing managed ranges fails", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(domain.Database{ID: databaseID, AddonID: addonID}, nil)
+		scClient.EXPECT().ListFirewallManagedRanges(ctx, databaseID, addonID).Return(nil, errors.New("boom"))
+
+		_, _, err := manager.ResolveFirewallManagedRanges(ctx, databaseID, []domain.FirewallRule{managedRangeRule})
+
+		require.EqualError(t, err, "list firewall managed ranges: boom")
+	})
+
+	t.Run("it fails when the range name does not exist", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(domain.Database{ID: databaseID, AddonID: addonID}, nil)
+		scClient.EXPECT().ListFirewallManagedRanges(ctx, databaseID, addonID).Return([]domain.FirewallManagedRange{
+			{ID: "man-osc-secnum-fr1-egress", Name: "Scalingo osc-secnum-fr1 apps"},
+		}, nil)
+
+		_, _, err := manager.ResolveFirewallManagedRanges(ctx, databaseID, []domain.FirewallRule{managedRangeRule})
+
+		require.ErrorIs(t, err, domain.ErrFirewallManagedRangeNotFound)
+	})
+
+	t.Run("it resolves the range names", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+		managedRange := domain.FirewallManagedRange{ID: "man-osc-fr1-egress", Name: "Scalingo osc-fr1 apps"}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(domain.Database{ID: databaseID, AddonID: addonID}, nil)
+		scClient.EXPECT().ListFirewallManagedRanges(ctx, databaseID, addonID).Return([]domain.FirewallManagedRange{managedRange}, nil)
+
+		resolvedRules, resolvedRanges, err := manager.ResolveFirewallManagedRanges(ctx, databaseID, []domain.FirewallRule{managedRangeRule})
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.FirewallRule{{Type: domain.FirewallRuleTypeManagedRange, RangeID: "man-osc-fr1-egress"}}, resolvedRules)
+		require.Equal(t, []domain.FirewallManagedRange{managedRange}, resolvedRanges)
+	})
+}
+
 func TestManager_updateFirewallRules(t *testing.T) {
 	t.Run("it does nothing when current rules are already created", func(t *testing.T) {
 		// Given
@@ -27,15 +91,17 @@ func TestManager_updateFirewallRules(t *testing.T) {
 			CIDR:  "0.0.0.0/0",
 			Label: "Allow all",
 		}
+		currentRule := firewallCustomRule
+		currentRule.ManagedBy = domain.FirewallRuleManagedByOperator
 		currentDB := domain.Database{
 			ID:            databaseID,
 			AddonID:       addonID,
-			FireWallRules: []domain.FirewallRule{firewallCustomRule},
+			FireWallRules: []domain.FirewallRule{currentRule},
 		}
 		expectedRules := []domain.FirewallRule{firewallCustomRule}
 
 		// When
-		err := manager.updateFirewallRules(ctx, currentDB, expectedRules)
+		err := manager.updateFirewallRules(ctx, currentDB, expectedRules, false)
 
 		// Then
 		require.NoError(t, err)
@@ -60,7 +126,7 @@ func TestManager_updateFirewallRules(t *testing.T) {
 		expectedRules := []domain.FirewallRule{}
 
 		// When
-		err := manager.updateFirewallRules(ctx, currentDB, expectedRules)
+		err := manager.updateFirewallRules(ctx, currentDB, expectedRules, false)
 
 		// Then
 		require.NoError(t, err)
@@ -97,7 +163,7 @@ func TestManager_updateFirewallRules(t *testing.T) {
 			Return(errCreateRule)
 
 		// When
-		err := manager.updateFirewallRules(ctx, currentDB, expectedRules)
+		err := manager.updateFirewallRules(ctx, currentDB, expectedRules, false)
 
 		// Then
 		require.ErrorIs(t, err, errCreateRule)
@@ -131,7 +197,7 @@ func TestManager_updateFirewallRules(t *testing.T) {
 		scClient.EXPECT().CreateFirewallRule(gomock.Any(), currentDB.ID, currentDB.AddonID, firewallManagedRule)
 
 		// When
-		err := manager.updateFirewallRules(ctx, currentDB, expectedRules)
+		err := manager.updateFirewallRules(ctx, currentDB, expectedRules, false)
 
 		// Then
 		require.NoError(t, err)
@@ -148,9 +214,10 @@ func TestManager_updateFirewallRules(t *testing.T) {
 		}
 
 		ruleToDelete := domain.FirewallRule{
-			ID:   "rule-to-delete",
-			Type: domain.FirewallRuleTypeCustomRange,
-			CIDR: "192.168.1.0/24",
+			ID:        "rule-to-delete",
+			Type:      domain.FirewallRuleTypeCustomRange,
+			CIDR:      "192.168.1.0/24",
+			ManagedBy: domain.FirewallRuleManagedByOperator,
 		}
 		currentDB := domain.Database{
 			ID:            databaseID,
@@ -162,7 +229,7 @@ func TestManager_updateFirewallRules(t *testing.T) {
 		scClient.EXPECT().DeleteFirewallRule(gomock.Any(), currentDB.ID, currentDB.AddonID, ruleToDelete.ID)
 
 		// When
-		err := manager.updateFirewallRules(ctx, currentDB, expectedRules)
+		err := manager.updateFirewallRules(ctx, currentDB, expectedRules, false)
 
 		// Then
 		require.NoError(t, err)
@@ -179,13 +246,15 @@ func TestManager_updateFirewallRules(t *testing.T) {
 		}
 
 		oldRule := domain.FirewallRule{
-			ID:   "old-rule",
-			Type: domain.FirewallRuleTypeCustomRange,
-			CIDR: "10.0.0.0/8",
+			ID:        "old-rule",
+			Type:      domain.FirewallRuleTypeCustomRange,
+			CIDR:      "10.0.0.0/8",
+			ManagedBy: domain.FirewallRuleManagedByOperator,
 		}
 		keepRule := domain.FirewallRule{
-			Type: domain.FirewallRuleTypeCustomRange,
-			CIDR: "192.168.1.0/24",
+			Type:      domain.FirewallRuleTypeCustomRange,
+			CIDR:      "192.168.1.0/24",
+			ManagedBy: domain.FirewallRuleManagedByOperator,
 		}
 		newRule := domain.FirewallRule{
 			Type:    domain.FirewallRuleTypeManagedRange,
@@ -203,15 +272,94 @@ func TestManager_updateFirewallRules(t *testing.T) {
 		scClient.EXPECT().DeleteFirewallRule(gomock.Any(), currentDB.ID, currentDB.AddonID, oldRule.ID)
 
 		// When
-		err := manager.updateFirewallRules(ctx, currentDB, expectedRules)
+		err := manager.updateFirewallRules(ctx, currentDB, expectedRules, false)
 
 		// Then
 		require.NoError(t, err)
 	})
 }
 
+func TestManager_updateFirewallRules_replace(t *testing.T) {
+	t.Run("it adds the replacement before deleting the rule", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+
+		manager := manager{
+			scClient: scClient,
+		}
+
+		currentRule := domain.FirewallRule{
+			ID:        "rule-1",
+			Type:      domain.FirewallRuleTypeCustomRange,
+			CIDR:      "192.168.1.0/24",
+			Label:     "Old label",
+			ManagedBy: domain.FirewallRuleManagedByOperator,
+		}
+		expectedRule := domain.FirewallRule{
+			Type:  domain.FirewallRuleTypeCustomRange,
+			CIDR:  "192.168.1.0/24",
+			Label: "New label",
+		}
+		currentDB := domain.Database{
+			ID:            databaseID,
+			AddonID:       addonID,
+			FireWallRules: []domain.FirewallRule{currentRule},
+		}
+
+		gomock.InOrder(
+			scClient.EXPECT().CreateFirewallRule(gomock.Any(), databaseID, addonID, expectedRule),
+			scClient.EXPECT().DeleteFirewallRule(gomock.Any(), databaseID, addonID, currentRule.ID),
+		)
+
+		err := manager.updateFirewallRules(ctx, currentDB, []domain.FirewallRule{expectedRule}, false)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it keeps the adopted rules when adding their replacement fails", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+
+		manager := manager{
+			scClient: scClient,
+		}
+
+		unmarkedRule := domain.FirewallRule{
+			ID:    "rule-1",
+			Type:  domain.FirewallRuleTypeCustomRange,
+			CIDR:  "192.168.1.0/24",
+			Label: "Office",
+		}
+		staleRule := domain.FirewallRule{
+			ID:        "rule-2",
+			Type:      domain.FirewallRuleTypeCustomRange,
+			CIDR:      "10.0.0.0/8",
+			ManagedBy: domain.FirewallRuleManagedByOperator,
+		}
+		expectedRule := domain.FirewallRule{
+			Type:  domain.FirewallRuleTypeCustomRange,
+			CIDR:  "192.168.1.0/24",
+			Label: "Office",
+		}
+		currentDB := domain.Database{
+			ID:            databaseID,
+			AddonID:       addonID,
+			FireWallRules: []domain.FirewallRule{unmarkedRule, staleRule},
+		}
+
+		errCreateRule := errors.New("create firewall rule")
+		scClient.EXPECT().CreateFirewallRule(gomock.Any(), databaseID, addonID, expectedRule).Return(errCreateRule)
+
+		err := manager.updateFirewallRules(ctx, currentDB, []domain.FirewallRule{expectedRule}, false)
+
+		require.ErrorIs(t, err, errCreateRule)
+	})
+}
+
 func TestManager_establishRulesToApply(t *testing.T) {
-	t.Run("it establishes rules to apply", func(t *testing.T) {
+	t.Run("it establishes rules to apply on an exclusive firewall", func(t *testing.T) {
 		// Given
 		current := []domain.FirewallRule{
 			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "first"},
@@ -228,27 +376,74 @@ func TestManager_establishRulesToApply(t *testing.T) {
 			// Added.
 			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "0.0.0.0/0", Label: "all"}, addRuleAction},
 			{domain.FirewallRule{Type: domain.FirewallRuleTypeManagedRange, RangeID: "range-2"}, addRuleAction},
+			// Replaced, because of the label change.
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "first"}, deleteRuleAction},
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "redundant"}, addRuleAction},
 			// Deleted.
 			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.222/24"}, deleteRuleAction},
 			{domain.FirewallRule{Type: domain.FirewallRuleTypeManagedRange, RangeID: "range-1"}, deleteRuleAction},
 		}
 
 		// When
-		res := establishRulesToApply(current, requested)
+		res := establishRulesToApply(current, requested, true)
 
 		// Then
 		require.ElementsMatch(t, expected, res)
 	})
 
+	t.Run("it leaves the rules not created by the operator alone", func(t *testing.T) {
+		current := []domain.FirewallRule{
+			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.2/32", Label: "dashboard"},
+			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.3/32", Label: "old", ManagedBy: domain.FirewallRuleManagedByOperator},
+			{Type: domain.FirewallRuleTypeManagedRange, RangeID: "range-1", ManagedBy: domain.FirewallRuleManagedByOperator},
+		}
+		requested := []domain.FirewallRule{
+			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.3/32", Label: "new"},
+		}
+
+		res := establishRulesToApply(current, requested, false)
+
+		require.ElementsMatch(t, rulesToApply{
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.3/32", Label: "old", ManagedBy: domain.FirewallRuleManagedByOperator}, deleteRuleAction},
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.3/32", Label: "new"}, addRuleAction},
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeManagedRange, RangeID: "range-1", ManagedBy: domain.FirewallRuleManagedByOperator}, deleteRuleAction},
+		}, res)
+	})
+
+	t.Run("it adopts the unmarked rules matching the expected rules", func(t *testing.T) {
+		current := []domain.FirewallRule{
+			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "spec"},
+			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.2/32", Label: "dashboard"},
+			{Type: domain.FirewallRuleTypeManagedRange, RangeID: "range-1"},
+		}
+		requested := []domain.FirewallRule{
+			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "spec"},
+			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.2/32", Label: "renamed"},
+		}
+
+		res := establishRulesToApply(current, requested, false)
+
+		require.ElementsMatch(t, rulesToApply{
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "spec"}, deleteRuleAction},
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "spec"}, addRuleAction},
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.2/32", Label: "dashboard"}, deleteRuleAction},
+			{domain.FirewallRule{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.2/32", Label: "renamed"}, addRuleAction},
+		}, res)
+	})
+
 	t.Run("it returns empty when rules are identical", func(t *testing.T) {
 		// Given
 		rules := []domain.FirewallRule{
 			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "first"},
 			{Type: domain.FirewallRuleTypeManagedRange, RangeID: "range-1"},
 		}
+		currentRules := []domain.FirewallRule{
+			{Type: domain.FirewallRuleTypeCustomRange, CIDR: "192.168.0.1/24", Label: "first", ManagedBy: domain.FirewallRuleManagedByOperator},
+			{Type: domain.FirewallRuleTypeManagedRange, RangeID: "range-1", ManagedBy: domain.FirewallRuleManagedByOperator},
+		}
 
 		// When
-		res := establishRulesToApply(rules, rules)
+		res := establishRulesToApply(currentRules, rules, false)
 
 		// Then
 		require.Empty(t, res)
@@ -12,10 +12,13 @@ import (
 
 type manager struct {
 	dbType   domain.DatabaseType
+	region   string
 	scClient scalingo.Client
 }
 
-func NewManager(ctx context.Context, dbType domain.DatabaseType, apiToken, region string) (database.Manager, error) {
+// NewManager returns the manager of the databases of the given type. The databases are addons of the
+// application appID when set, dedicated resources databases otherwise.
+func NewManager(ctx context.Context, dbType domain.DatabaseType, apiToken, region, appID string) (database.Manager, error) {
 	err := dbType.Validate()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, "new manager")
@@ -24,13 +27,19 @@ func NewManager(ctx context.Context, dbType domain.DatabaseType, apiToken, regio
 		return nil, errors.New(ctx, "empty api token")
 	}
 
-	scClient, err := scalingobase.NewClient(ctx, apiToken, region)
+	var scClient scalingo.Client
+	if appID != "" {
+		scClient, err = scalingobase.NewAppAddonClient(ctx, apiToken, region, appID)
+	} else {
+		scClient, err = scalingobase.NewClient(ctx, apiToken, region)
+	}
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, "new scalingo client")
 	}
 
 	return &manager{
 		dbType:   dbType,
+		region:   region,
 		scClient: scClient,
 	}, nil
 }
@@ -40,16 +49,21 @@ func (m *manager) CreateDatabase(ctx context.Context, db domain.Database) (domai
 }
 
 // applyInstantDatabaseUpdates applies updates that do NOT require provisioning,
-// such as firewall rules update.
+// such as firewall rules or features update.
 // These updates are applied instantly or within few seconds.
 func (m *manager) applyInstantDatabaseUpdates(ctx context.Context, db, expectedDB domain.Database) error {
 	// Note: a `m.updateInternetAccess` implementation is available in this PR:
 	// https://github.com/Scalingo/scalingo-operator/pull/22
 
-	err := m.updateFirewallRules(ctx, db, expectedDB.FireWallRules)
+	err := m.updateFirewallRules(ctx, db, expectedDB.FireWallRules, expectedDB.IsFirewallExclusive)
 	if err != nil {
 		return errors.Wrap(ctx, err, "update firewall rules")
 	}
+
+	err = m.updateFeatures(ctx, db, expectedDB.Features)
+	if err != nil {
+		return errors.Wrap(ctx, err, "update features")
+	}
 	return nil
 }
 
@@ -72,6 +86,14 @@ func toDatabaseTypeName(ctx context.Context, dbType domain.DatabaseType) (string
 	switch dbType {
 	case domain.DatabaseTypePostgreSQL:
 		return "POSTGRESQL", nil
+	case domain.DatabaseTypeMySQL:
+		return "MYSQL", nil
+	case domain.DatabaseTypeRedis:
+		return "REDIS", nil
+	case domain.DatabaseTypeMongoDB:
+		return "MONGO", nil
+	case domain.DatabaseTypeOpenSearch:
+		return "OPENSEARCH", nil
 	default:
 		return "", errors.Newf(ctx, "no matching type for %q", dbType)
 	}
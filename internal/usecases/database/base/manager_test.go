@@ -19,7 +19,7 @@ const (
 func TestNewManager(t *testing.T) {
 	t.Run("it fails because of bad database type", func(t *testing.T) {
 		ctx := t.Context()
-		dbManager, err := NewManager(ctx, "invalid_db_type", "", "")
+		dbManager, err := NewManager(ctx, "invalid_db_type", "", "", "")
 
 		require.EqualError(t, err, "new manager: invalid database type: invalid_db_type")
 		require.Nil(t, dbManager)
@@ -27,7 +27,7 @@ func TestNewManager(t *testing.T) {
 
 	t.Run("it fails because of empty API token", func(t *testing.T) {
 		ctx := t.Context()
-		dbManager, err := NewManager(ctx, domain.DatabaseTypePostgreSQL, "", "")
+		dbManager, err := NewManager(ctx, domain.DatabaseTypePostgreSQL, "", "", "")
 
 		require.EqualError(t, err, "empty api token")
 		require.Nil(t, dbManager)
@@ -112,6 +112,22 @@ func TestToDatabaseTypeName(t *testing.T) {
 			dbType:     domain.DatabaseTypePostgreSQL,
 			dbTypeName: "POSTGRESQL",
 		},
+		"it successfully extract MySQL type name": {
+			dbType:     domain.DatabaseTypeMySQL,
+			dbTypeName: "MYSQL",
+		},
+		"it successfully extract Redis type name": {
+			dbType:     domain.DatabaseTypeRedis,
+			dbTypeName: "REDIS",
+		},
+		"it successfully extract MongoDB type name": {
+			dbType:     domain.DatabaseTypeMongoDB,
+			dbTypeName: "MONGO",
+		},
+		"it successfully extract OpenSearch type name": {
+			dbType:     domain.DatabaseTypeOpenSearch,
+			dbTypeName: "OPENSEARCH",
+		},
 	}
 
 	for name, test := range tests {
@@ -129,7 +145,7 @@ func TestToDatabaseTypeName(t *testing.T) {
 
 	t.Run("it fails because of bad database type", func(t *testing.T) {
 		ctx := t.Context()
-		dbManager, err := NewManager(ctx, "invalid_db_type", "", "")
+		dbManager, err := NewManager(ctx, "invalid_db_type", "", "", "")
 
 		require.EqualError(t, err, "new manager: invalid database type: invalid_db_type")
 		require.Nil(t, dbManager)
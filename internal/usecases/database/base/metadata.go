@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func (m *manager) GetDatabaseMetadata(ctx context.Context, dbID string) (domain.DatabaseMetadata, error) {
+	if dbID == "" {
+		return domain.DatabaseMetadata{}, errors.New(ctx, "empty database id")
+	}
+
+	dbTypeName, err := toDatabaseTypeName(ctx, m.dbType)
+	if err != nil {
+		return domain.DatabaseMetadata{}, errors.Wrap(ctx, err, "to database type name")
+	}
+
+	db, err := m.scClient.GetDatabase(ctx, dbID)
+	if err != nil {
+		return domain.DatabaseMetadata{}, errors.Wrapf(ctx, err, "get database %s", dbID)
+	}
+
+	endpoints, err := m.scClient.ListDatabaseEndpoints(ctx, dbID)
+	if err != nil {
+		return domain.DatabaseMetadata{}, errors.Wrap(ctx, err, "list database endpoints")
+	}
+
+	netPeerings, err := m.scClient.ListDatabaseNetPeerings(ctx, dbID)
+	if err != nil {
+		return domain.DatabaseMetadata{}, errors.Wrap(ctx, err, "list database net peerings")
+	}
+
+	outscaleNetPeeringIDs := make([]string, 0, len(netPeerings))
+	for _, netPeering := range netPeerings {
+		outscaleNetPeeringIDs = append(outscaleNetPeeringIDs, netPeering.OutscaleNetPeeringID)
+	}
+
+	return domain.DatabaseMetadata{
+		Name:                  "SCALINGO_" + dbTypeName,
+		DatabaseID:            db.ID,
+		Region:                m.region,
+		Plan:                  db.Plan,
+		Version:               db.Version,
+		Endpoints:             endpoints,
+		OutscaleNetPeeringIDs: outscaleNetPeeringIDs,
+	}, nil
+}
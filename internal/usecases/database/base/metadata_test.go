@@ -0,0 +1,82 @@
+package database
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/scalingomock"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestManager_GetDatabaseMetadata(t *testing.T) {
+	t.Run("it fails because of empty ID", func(t *testing.T) {
+		ctx := t.Context()
+		manager := manager{}
+		res, err := manager.GetDatabaseMetadata(ctx, "")
+
+		require.EqualError(t, err, "empty database id")
+		require.Empty(t, res)
+	})
+
+	t.Run("it fails when listing endpoints fails", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+
+		manager := manager{
+			dbType:   domain.DatabaseTypePostgreSQL,
+			scClient: scClient,
+		}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(domain.Database{ID: databaseID}, nil)
+		scClient.EXPECT().ListDatabaseEndpoints(ctx, databaseID).Return(nil, errors.New("boom"))
+
+		_, err := manager.GetDatabaseMetadata(ctx, databaseID)
+
+		require.EqualError(t, err, "list database endpoints: boom")
+	})
+
+	t.Run("it successfully gets database metadata", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+
+		manager := manager{
+			dbType:   domain.DatabaseTypePostgreSQL,
+			region:   "osc-fr1",
+			scClient: scClient,
+		}
+
+		endpoints := []domain.DatabaseEndpoint{{
+			Hostname: "my-db.postgresql.osc-fr1.scalingo-dbs.com",
+			Port:     30000,
+			Type:     domain.DatabaseEndpointTypePublicRW,
+		}}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(domain.Database{
+			ID:      databaseID,
+			Plan:    "postgresql-dr-enterprise-4096",
+			Version: "17.4.0-1",
+		}, nil)
+		scClient.EXPECT().ListDatabaseEndpoints(ctx, databaseID).Return(endpoints, nil)
+		scClient.EXPECT().ListDatabaseNetPeerings(ctx, databaseID).Return([]domain.DatabaseNetPeering{
+			{ID: "np-1", OutscaleNetPeeringID: "pcx-1234"},
+		}, nil)
+
+		res, err := manager.GetDatabaseMetadata(ctx, databaseID)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.DatabaseMetadata{
+			Name:                  "SCALINGO_POSTGRESQL",
+			DatabaseID:            databaseID,
+			Region:                "osc-fr1",
+			Plan:                  "postgresql-dr-enterprise-4096",
+			Version:               "17.4.0-1",
+			Endpoints:             endpoints,
+			OutscaleNetPeeringIDs: []string{"pcx-1234"},
+		}, res)
+	})
+}
@@ -46,30 +46,30 @@ func (m *manager) GetDatabaseEndpoints(ctx context.Context, dbID string) ([]doma
 	return endpoints, nil
 }
 
-func (m *manager) EnsureDatabaseNetPeering(ctx context.Context, dbID, outscaleNetPeeringID string) error {
+func (m *manager) EnsureDatabaseNetPeering(ctx context.Context, dbID, outscaleNetPeeringID string) (domain.DatabaseNetPeering, error) {
 	if dbID == "" {
-		return errors.New(ctx, "empty database id")
+		return domain.DatabaseNetPeering{}, errors.New(ctx, "empty database id")
 	}
 	if outscaleNetPeeringID == "" {
-		return errors.New(ctx, "empty outscale net peering id")
+		return domain.DatabaseNetPeering{}, errors.New(ctx, "empty outscale net peering id")
 	}
 
 	netPeerings, err := m.scClient.ListDatabaseNetPeerings(ctx, dbID)
 	if err != nil {
-		return errors.Wrap(ctx, err, "list database net peerings")
+		return domain.DatabaseNetPeering{}, errors.Wrap(ctx, err, "list database net peerings")
 	}
 
 	for _, netPeering := range netPeerings {
 		if netPeering.OutscaleNetPeeringID == outscaleNetPeeringID {
-			return nil
+			return netPeering, nil
 		}
 	}
 
-	_, err = m.scClient.CreateDatabaseNetPeering(ctx, dbID, outscaleNetPeeringID)
+	netPeering, err := m.scClient.CreateDatabaseNetPeering(ctx, dbID, outscaleNetPeeringID)
 	if err != nil {
-		return errors.Wrap(ctx, err, "create database net peering")
+		return domain.DatabaseNetPeering{}, errors.Wrap(ctx, err, "create database net peering")
 	}
-	return nil
+	return netPeering, nil
 }
 
 func (m *manager) DeleteDatabaseNetPeering(ctx context.Context, dbID string, netPeeringID string) error {
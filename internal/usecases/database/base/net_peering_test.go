@@ -176,7 +176,7 @@ func TestManager_EnsureDatabaseNetPeering(t *testing.T) {
 	t.Run("it fails because of empty database ID", func(t *testing.T) {
 		ctx := t.Context()
 		manager := manager{}
-		err := manager.EnsureDatabaseNetPeering(ctx, "", "pcx-1234")
+		_, err := manager.EnsureDatabaseNetPeering(ctx, "", "pcx-1234")
 
 		require.EqualError(t, err, "empty database id")
 	})
@@ -184,7 +184,7 @@ func TestManager_EnsureDatabaseNetPeering(t *testing.T) {
 	t.Run("it fails because of empty net peering ID", func(t *testing.T) {
 		ctx := t.Context()
 		manager := manager{}
-		err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "")
+		_, err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "")
 
 		require.EqualError(t, err, "empty outscale net peering id")
 	})
@@ -200,7 +200,7 @@ func TestManager_EnsureDatabaseNetPeering(t *testing.T) {
 
 		scClient.EXPECT().ListDatabaseNetPeerings(ctx, databaseID).Return(nil, errors.New("boom"))
 
-		err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "pcx-1234")
+		_, err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "pcx-1234")
 		require.EqualError(t, err, "list database net peerings: boom")
 	})
 
@@ -217,11 +217,17 @@ func TestManager_EnsureDatabaseNetPeering(t *testing.T) {
 			{
 				ID:                   "np-1",
 				OutscaleNetPeeringID: "pcx-1234",
+				Status:               domain.DatabaseNetPeeringStatusActive,
 			},
 		}, nil)
 
-		err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "pcx-1234")
+		netPeering, err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "pcx-1234")
 		require.NoError(t, err)
+		require.Equal(t, domain.DatabaseNetPeering{
+			ID:                   "np-1",
+			OutscaleNetPeeringID: "pcx-1234",
+			Status:               domain.DatabaseNetPeeringStatusActive,
+		}, netPeering)
 	})
 
 	t.Run("it creates database net peering when missing", func(t *testing.T) {
@@ -239,8 +245,9 @@ func TestManager_EnsureDatabaseNetPeering(t *testing.T) {
 			OutscaleNetPeeringID: "pcx-1234",
 		}, nil)
 
-		err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "pcx-1234")
+		netPeering, err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "pcx-1234")
 		require.NoError(t, err)
+		require.Equal(t, "np-1", netPeering.ID)
 	})
 
 	t.Run("returns error when creating database net peering fails", func(t *testing.T) {
@@ -255,7 +262,7 @@ func TestManager_EnsureDatabaseNetPeering(t *testing.T) {
 		scClient.EXPECT().ListDatabaseNetPeerings(ctx, databaseID).Return([]domain.DatabaseNetPeering{}, nil)
 		scClient.EXPECT().CreateDatabaseNetPeering(ctx, databaseID, "pcx-1234").Return(domain.DatabaseNetPeering{}, errors.New("boom"))
 
-		err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "pcx-1234")
+		_, err := manager.EnsureDatabaseNetPeering(ctx, databaseID, "pcx-1234")
 		require.EqualError(t, err, "create database net peering: boom")
 	})
 }
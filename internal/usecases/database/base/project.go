@@ -0,0 +1,37 @@
+package database
+
+import (
+	"context"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/Scalingo/go-utils/errors/v3"
+)
+
+// EnsureDatabaseProject moves the database to the given project, unless it already is in this project, and
+// returns the project of the database. The moves refused by Scalingo wrap domain.ErrDatabaseProjectMoveRejected.
+func (m *manager) EnsureDatabaseProject(ctx context.Context, dbID, projectID string) (string, error) {
+	log := logf.FromContext(ctx)
+
+	if dbID == "" {
+		return "", errors.New(ctx, "empty database id")
+	}
+	if projectID == "" {
+		return "", errors.New(ctx, "empty project id")
+	}
+
+	db, err := m.scClient.GetDatabase(ctx, dbID)
+	if err != nil {
+		return "", errors.Wrapf(ctx, err, "get database %s", dbID)
+	}
+	if db.ProjectID == projectID {
+		return db.ProjectID, nil
+	}
+
+	log.Info("Move database to project", "database", dbID, "from", db.ProjectID, "to", projectID)
+	err = m.scClient.SetDatabaseProject(ctx, db, projectID)
+	if err != nil {
+		return db.ProjectID, errors.Wrap(ctx, err, "set database project")
+	}
+	return projectID, nil
+}
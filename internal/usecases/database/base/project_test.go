@@ -0,0 +1,86 @@
+package database
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/scalingomock"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+func TestManager_EnsureDatabaseProject(t *testing.T) {
+	const (
+		currentProjectID  = "prj_current_id"
+		expectedProjectID = "prj_expected_id"
+	)
+	db := domain.Database{ID: databaseID, AppID: appID, ProjectID: currentProjectID}
+
+	t.Run("it fails because of empty project ID", func(t *testing.T) {
+		ctx := t.Context()
+		manager := manager{}
+
+		_, err := manager.EnsureDatabaseProject(ctx, databaseID, "")
+
+		require.EqualError(t, err, "empty project id")
+	})
+
+	t.Run("it does nothing when the database is in the project", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(db, nil)
+
+		projectID, err := manager.EnsureDatabaseProject(ctx, databaseID, currentProjectID)
+
+		require.NoError(t, err)
+		require.Equal(t, currentProjectID, projectID)
+	})
+
+	t.Run("it moves the database to the project", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(db, nil)
+		scClient.EXPECT().SetDatabaseProject(ctx, db, expectedProjectID).Return(nil)
+
+		projectID, err := manager.EnsureDatabaseProject(ctx, databaseID, expectedProjectID)
+
+		require.NoError(t, err)
+		require.Equal(t, expectedProjectID, projectID)
+	})
+
+	t.Run("it returns the current project when the move is rejected", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(db, nil)
+		scClient.EXPECT().SetDatabaseProject(ctx, db, expectedProjectID).Return(domain.ErrDatabaseProjectMoveRejected)
+
+		projectID, err := manager.EnsureDatabaseProject(ctx, databaseID, expectedProjectID)
+
+		require.ErrorIs(t, err, domain.ErrDatabaseProjectMoveRejected)
+		require.Equal(t, currentProjectID, projectID)
+	})
+
+	t.Run("it fails to get the database", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetDatabase(ctx, databaseID).Return(domain.Database{}, errors.New("boom"))
+
+		_, err := manager.EnsureDatabaseProject(ctx, databaseID, expectedProjectID)
+
+		require.EqualError(t, err, "get database db_test_id: boom")
+	})
+}
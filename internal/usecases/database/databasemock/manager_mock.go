@@ -94,12 +94,28 @@ func (mr *MockManagerMockRecorder) DeleteDatabaseNetPeering(ctx, dbID, outscaleN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseNetPeering", reflect.TypeOf((*MockManager)(nil).DeleteDatabaseNetPeering), ctx, dbID, outscaleNetPeeringID)
 }
 
+// EnsureAppVariable mocks base method.
+func (m *MockManager) EnsureAppVariable(ctx context.Context, appID, name, value string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureAppVariable", ctx, appID, name, value)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureAppVariable indicates an expected call of EnsureAppVariable.
+func (mr *MockManagerMockRecorder) EnsureAppVariable(ctx, appID, name, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureAppVariable", reflect.TypeOf((*MockManager)(nil).EnsureAppVariable), ctx, appID, name, value)
+}
+
 // EnsureDatabaseNetPeering mocks base method.
-func (m *MockManager) EnsureDatabaseNetPeering(ctx context.Context, dbID, outscaleNetPeeringID string) error {
+func (m *MockManager) EnsureDatabaseNetPeering(ctx context.Context, dbID, outscaleNetPeeringID string) (domain.DatabaseNetPeering, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "EnsureDatabaseNetPeering", ctx, dbID, outscaleNetPeeringID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(domain.DatabaseNetPeering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // EnsureDatabaseNetPeering indicates an expected call of EnsureDatabaseNetPeering.
@@ -108,6 +124,21 @@ func (mr *MockManagerMockRecorder) EnsureDatabaseNetPeering(ctx, dbID, outscaleN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDatabaseNetPeering", reflect.TypeOf((*MockManager)(nil).EnsureDatabaseNetPeering), ctx, dbID, outscaleNetPeeringID)
 }
 
+// EnsureDatabaseProject mocks base method.
+func (m *MockManager) EnsureDatabaseProject(ctx context.Context, dbID, projectID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureDatabaseProject", ctx, dbID, projectID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureDatabaseProject indicates an expected call of EnsureDatabaseProject.
+func (mr *MockManagerMockRecorder) EnsureDatabaseProject(ctx, dbID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDatabaseProject", reflect.TypeOf((*MockManager)(nil).EnsureDatabaseProject), ctx, dbID, projectID)
+}
+
 // GetDatabase mocks base method.
 func (m *MockManager) GetDatabase(ctx context.Context, dbID string) (domain.Database, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +154,36 @@ func (mr *MockManagerMockRecorder) GetDatabase(ctx, dbID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabase", reflect.TypeOf((*MockManager)(nil).GetDatabase), ctx, dbID)
 }
 
+// GetDatabaseCACertificate mocks base method.
+func (m *MockManager) GetDatabaseCACertificate(ctx context.Context, db domain.Database) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseCACertificate", ctx, db)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseCACertificate indicates an expected call of GetDatabaseCACertificate.
+func (mr *MockManagerMockRecorder) GetDatabaseCACertificate(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseCACertificate", reflect.TypeOf((*MockManager)(nil).GetDatabaseCACertificate), ctx, db)
+}
+
+// GetDatabaseDashboardsURL mocks base method.
+func (m *MockManager) GetDatabaseDashboardsURL(ctx context.Context, db domain.Database) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseDashboardsURL", ctx, db)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseDashboardsURL indicates an expected call of GetDatabaseDashboardsURL.
+func (mr *MockManagerMockRecorder) GetDatabaseDashboardsURL(ctx, db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseDashboardsURL", reflect.TypeOf((*MockManager)(nil).GetDatabaseDashboardsURL), ctx, db)
+}
+
 // GetDatabaseEndpoints mocks base method.
 func (m *MockManager) GetDatabaseEndpoints(ctx context.Context, dbID string) ([]domain.DatabaseEndpoint, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +199,21 @@ func (mr *MockManagerMockRecorder) GetDatabaseEndpoints(ctx, dbID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseEndpoints", reflect.TypeOf((*MockManager)(nil).GetDatabaseEndpoints), ctx, dbID)
 }
 
+// GetDatabaseMetadata mocks base method.
+func (m *MockManager) GetDatabaseMetadata(ctx context.Context, dbID string) (domain.DatabaseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseMetadata", ctx, dbID)
+	ret0, _ := ret[0].(domain.DatabaseMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseMetadata indicates an expected call of GetDatabaseMetadata.
+func (mr *MockManagerMockRecorder) GetDatabaseMetadata(ctx, dbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseMetadata", reflect.TypeOf((*MockManager)(nil).GetDatabaseMetadata), ctx, dbID)
+}
+
 // GetDatabaseNetPeerings mocks base method.
 func (m *MockManager) GetDatabaseNetPeerings(ctx context.Context, dbID string) ([]domain.DatabaseNetPeering, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +259,36 @@ func (mr *MockManagerMockRecorder) GetDatabaseURL(ctx, db any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseURL", reflect.TypeOf((*MockManager)(nil).GetDatabaseURL), ctx, db)
 }
 
+// ResolveFirewallManagedRanges mocks base method.
+func (m *MockManager) ResolveFirewallManagedRanges(ctx context.Context, dbID string, rules []domain.FirewallRule) ([]domain.FirewallRule, []domain.FirewallManagedRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFirewallManagedRanges", ctx, dbID, rules)
+	ret0, _ := ret[0].([]domain.FirewallRule)
+	ret1, _ := ret[1].([]domain.FirewallManagedRange)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResolveFirewallManagedRanges indicates an expected call of ResolveFirewallManagedRanges.
+func (mr *MockManagerMockRecorder) ResolveFirewallManagedRanges(ctx, dbID, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFirewallManagedRanges", reflect.TypeOf((*MockManager)(nil).ResolveFirewallManagedRanges), ctx, dbID, rules)
+}
+
+// UnsetAppVariable mocks base method.
+func (m *MockManager) UnsetAppVariable(ctx context.Context, appID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetAppVariable", ctx, appID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetAppVariable indicates an expected call of UnsetAppVariable.
+func (mr *MockManagerMockRecorder) UnsetAppVariable(ctx, appID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetAppVariable", reflect.TypeOf((*MockManager)(nil).UnsetAppVariable), ctx, appID, name)
+}
+
 // UpdateDatabase mocks base method.
 func (m *MockManager) UpdateDatabase(ctx context.Context, dbID string, expectedDB domain.Database) (domain.DatabaseStatus, error) {
 	m.ctrl.T.Helper()
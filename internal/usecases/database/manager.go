@@ -11,11 +11,18 @@ type Manager interface {
 	CheckDatabaseExists(ctx context.Context, dbID string) (bool, error)
 	GetDatabase(ctx context.Context, dbID string) (domain.Database, error)
 	GetDatabaseURL(ctx context.Context, db domain.Database) (domain.DatabaseURL, error)
+	GetDatabaseDashboardsURL(ctx context.Context, db domain.Database) (string, error)
+	GetDatabaseCACertificate(ctx context.Context, db domain.Database) (string, error)
 	GetDatabaseEndpoints(ctx context.Context, dbID string) ([]domain.DatabaseEndpoint, error)
+	GetDatabaseMetadata(ctx context.Context, dbID string) (domain.DatabaseMetadata, error)
 	GetDatabaseNetworkConfiguration(ctx context.Context, dbID string) (domain.DatabaseNetworkConfiguration, error)
 	GetDatabaseNetPeerings(ctx context.Context, dbID string) ([]domain.DatabaseNetPeering, error)
-	EnsureDatabaseNetPeering(ctx context.Context, dbID, outscaleNetPeeringID string) error
+	EnsureDatabaseNetPeering(ctx context.Context, dbID, outscaleNetPeeringID string) (domain.DatabaseNetPeering, error)
 	DeleteDatabaseNetPeering(ctx context.Context, dbID, outscaleNetPeeringID string) error
+	EnsureDatabaseProject(ctx context.Context, dbID, projectID string) (string, error)
+	EnsureAppVariable(ctx context.Context, appID, name, value string) (bool, error)
+	UnsetAppVariable(ctx context.Context, appID, name string) error
+	ResolveFirewallManagedRanges(ctx context.Context, dbID string, rules []domain.FirewallRule) ([]domain.FirewallRule, []domain.FirewallManagedRange, error)
 	UpdateDatabase(ctx context.Context, dbID string, expectedDB domain.Database) (domain.DatabaseStatus, error)
 	DeleteDatabase(ctx context.Context, dbID string) error
 }
@@ -0,0 +1,87 @@
+package project
+
+import (
+	"context"
+
+	errors "github.com/Scalingo/go-utils/errors/v3"
+	scalingo "github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo"
+	scalingobase "github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/base"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+	"github.com/Scalingo/scalingo-operator/internal/usecases/project"
+)
+
+type manager struct {
+	scClient scalingo.Client
+}
+
+// NewManager returns the manager of the Scalingo projects.
+func NewManager(ctx context.Context, apiToken, region string) (project.Manager, error) {
+	if apiToken == "" {
+		return nil, errors.New(ctx, "empty api token")
+	}
+
+	scClient, err := scalingobase.NewClient(ctx, apiToken, region)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "new scalingo client")
+	}
+
+	return &manager{
+		scClient: scClient,
+	}, nil
+}
+
+func (m *manager) CreateProject(ctx context.Context, project domain.Project) (domain.Project, error) {
+	return m.scClient.CreateProject(ctx, project)
+}
+
+// GetProject returns the project with its private network, if any.
+func (m *manager) GetProject(ctx context.Context, projectID string) (domain.Project, error) {
+	project, err := m.scClient.GetProject(ctx, projectID)
+	if err != nil {
+		return domain.Project{}, errors.Wrap(ctx, err, "get project")
+	}
+
+	privateNetwork, err := m.scClient.GetProjectPrivateNetwork(ctx, projectID)
+	if errors.Is(err, domain.ErrProjectPrivateNetworkNotFound) {
+		return project, nil
+	} else if err != nil {
+		return domain.Project{}, errors.Wrap(ctx, err, "get project private network")
+	}
+
+	project.PrivateNetwork = &privateNetwork
+	return project, nil
+}
+
+// UpdateProject updates the name and the default flag of the project when they differ from the expected ones,
+// and returns the project with its private network.
+func (m *manager) UpdateProject(ctx context.Context, projectID string, expectedProject domain.Project) (domain.Project, error) {
+	project, err := m.GetProject(ctx, projectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	if project.Name == expectedProject.Name && project.Default == expectedProject.Default {
+		return project, nil
+	}
+
+	updatedProject, err := m.scClient.UpdateProject(ctx, domain.Project{
+		ID:      projectID,
+		Name:    expectedProject.Name,
+		Default: expectedProject.Default,
+	})
+	if err != nil {
+		return domain.Project{}, errors.Wrap(ctx, err, "update project")
+	}
+
+	updatedProject.PrivateNetwork = project.PrivateNetwork
+	return updatedProject, nil
+}
+
+// DeleteProject deletes the project. A project already deleted is not an error.
+func (m *manager) DeleteProject(ctx context.Context, projectID string) error {
+	err := m.scClient.DeleteProject(ctx, projectID)
+	if err != nil && !errors.Is(err, domain.ErrProjectNotFound) {
+		return errors.Wrap(ctx, err, "delete project")
+	}
+	return nil
+}
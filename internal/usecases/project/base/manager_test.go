@@ -0,0 +1,136 @@
+package project
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Scalingo/scalingo-operator/internal/boundaries/out/scalingo/scalingomock"
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+const projectID = "prj_test_id"
+
+func TestNewManager(t *testing.T) {
+	t.Run("it fails because of empty API token", func(t *testing.T) {
+		ctx := t.Context()
+		projectManager, err := NewManager(ctx, "", "")
+
+		require.EqualError(t, err, "empty api token")
+		require.Nil(t, projectManager)
+	})
+}
+
+func TestManager_GetProject(t *testing.T) {
+	t.Run("it returns the project with its private network", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		privateNetwork := domain.ProjectPrivateNetwork{ID: "pn_test_id", Subnet: "10.240.0.0/24"}
+		scClient.EXPECT().GetProject(ctx, projectID).Return(domain.Project{ID: projectID, Name: "my-project"}, nil)
+		scClient.EXPECT().GetProjectPrivateNetwork(ctx, projectID).Return(privateNetwork, nil)
+
+		project, err := manager.GetProject(ctx, projectID)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.Project{ID: projectID, Name: "my-project", PrivateNetwork: &privateNetwork}, project)
+	})
+
+	t.Run("it returns the project without private network", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetProject(ctx, projectID).Return(domain.Project{ID: projectID, Name: "my-project"}, nil)
+		scClient.EXPECT().GetProjectPrivateNetwork(ctx, projectID).Return(domain.ProjectPrivateNetwork{}, domain.ErrProjectPrivateNetworkNotFound)
+
+		project, err := manager.GetProject(ctx, projectID)
+
+		require.NoError(t, err)
+		require.Nil(t, project.PrivateNetwork)
+	})
+
+	t.Run("it fails when getting the private network", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetProject(ctx, projectID).Return(domain.Project{ID: projectID}, nil)
+		scClient.EXPECT().GetProjectPrivateNetwork(ctx, projectID).Return(domain.ProjectPrivateNetwork{}, errors.New("boom"))
+
+		_, err := manager.GetProject(ctx, projectID)
+
+		require.EqualError(t, err, "get project private network: boom")
+	})
+}
+
+func TestManager_UpdateProject(t *testing.T) {
+	t.Run("it does nothing when the project is up to date", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().GetProject(ctx, projectID).Return(domain.Project{ID: projectID, Name: "my-project"}, nil)
+		scClient.EXPECT().GetProjectPrivateNetwork(ctx, projectID).Return(domain.ProjectPrivateNetwork{}, domain.ErrProjectPrivateNetworkNotFound)
+
+		project, err := manager.UpdateProject(ctx, projectID, domain.Project{Name: "my-project"})
+
+		require.NoError(t, err)
+		require.Equal(t, domain.Project{ID: projectID, Name: "my-project"}, project)
+	})
+
+	t.Run("it updates the name and the default flag", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		privateNetwork := domain.ProjectPrivateNetwork{ID: "pn_test_id"}
+		expectedProject := domain.Project{ID: projectID, Name: "new-name", Default: true}
+		scClient.EXPECT().GetProject(ctx, projectID).Return(domain.Project{ID: projectID, Name: "my-project"}, nil)
+		scClient.EXPECT().GetProjectPrivateNetwork(ctx, projectID).Return(privateNetwork, nil)
+		scClient.EXPECT().UpdateProject(ctx, expectedProject).Return(expectedProject, nil)
+
+		project, err := manager.UpdateProject(ctx, projectID, domain.Project{Name: "new-name", Default: true})
+
+		require.NoError(t, err)
+		require.Equal(t, "new-name", project.Name)
+		require.True(t, project.Default)
+		require.Equal(t, &privateNetwork, project.PrivateNetwork)
+	})
+}
+
+func TestManager_DeleteProject(t *testing.T) {
+	t.Run("it ignores a project already deleted", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().DeleteProject(ctx, projectID).Return(domain.ErrProjectNotFound)
+
+		err := manager.DeleteProject(ctx, projectID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("it fails to delete the project", func(t *testing.T) {
+		ctx := t.Context()
+		ctrl := gomock.NewController(t)
+		scClient := scalingomock.NewMockClient(ctrl)
+		manager := manager{scClient: scClient}
+
+		scClient.EXPECT().DeleteProject(ctx, projectID).Return(errors.New("boom"))
+
+		err := manager.DeleteProject(ctx, projectID)
+
+		require.EqualError(t, err, "delete project: boom")
+	})
+}
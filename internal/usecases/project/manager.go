@@ -0,0 +1,14 @@
+package project
+
+import (
+	"context"
+
+	"github.com/Scalingo/scalingo-operator/internal/domain"
+)
+
+type Manager interface {
+	CreateProject(ctx context.Context, project domain.Project) (domain.Project, error)
+	GetProject(ctx context.Context, projectID string) (domain.Project, error)
+	UpdateProject(ctx context.Context, projectID string, expectedProject domain.Project) (domain.Project, error)
+	DeleteProject(ctx context.Context, projectID string) error
+}